@@ -3,6 +3,9 @@ package controller
 import (
 	"database/sql"
 	"encoding/json"
+	"errors"
+	"io"
+	"mime"
 	"net/http"
 	"strconv"
 	"strings"
@@ -10,30 +13,107 @@ import (
 
 	"github.com/go-chi/chi/v5"
 	"github.com/golang-jwt/jwt"
+	"github.com/michaelwongycn/job-portal/domain/enum"
 	"github.com/michaelwongycn/job-portal/domain/request"
 	"github.com/michaelwongycn/job-portal/domain/response"
 	"github.com/michaelwongycn/job-portal/lib/auth"
+	"github.com/michaelwongycn/job-portal/lib/ical"
+	"github.com/michaelwongycn/job-portal/lib/locale"
+	"github.com/michaelwongycn/job-portal/usecase/attachment"
+	"github.com/michaelwongycn/job-portal/usecase/company"
+	"github.com/michaelwongycn/job-portal/usecase/feed"
 	"github.com/michaelwongycn/job-portal/usecase/job"
+	"github.com/michaelwongycn/job-portal/usecase/profile"
 	"github.com/michaelwongycn/job-portal/usecase/user"
 )
 
 const (
-	invalidCredentialsErrorMsg = "Invalid Credentials"
-	passwordNotMatchErrorMsg   = "Password doesn't match"
-	unableToParseTokenErrorMsg = "Unable to parse token"
-	notFoundErrorMsg           = "Not Found"
-	internalServerErrorMsg     = "Internal Server Error"
+	invalidCredentialsErrorMsg     = "Invalid Credentials"
+	passwordNotMatchErrorMsg       = "Password doesn't match"
+	unableToParseTokenErrorMsg     = "Unable to parse token"
+	notFoundErrorMsg               = "Not Found"
+	internalServerErrorMsg         = "Internal Server Error"
+	invalidPaginationErrorMsg      = "Invalid Pagination"
+	invalidSortErrorMsg            = "Invalid Sort"
+	invalidSkillErrorMsg           = "Invalid Skill"
+	invalidCompanyErrorMsg         = "Invalid Company"
+	invalidProfileErrorMsg         = "Invalid Profile"
+	invalidDescriptionErrorMsg     = "Invalid Description"
+	invalidRequirementErrorMsg     = "Invalid Requirement"
+	invalidExpireDateErrorMsg      = "Invalid Expire Date"
+	invalidJobStatusErrorMsg       = "Invalid Job Status"
+	jobClosedErrorMsg              = "Job Closed"
+	invalidOpeningsErrorMsg        = "Invalid Openings"
+	invalidMaxApplicationsErrorMsg = "Invalid Max Applications"
+	invalidSavedSearchErrorMsg     = "Invalid Saved Search"
+	invalidQuestionErrorMsg        = "Invalid Question"
+	invalidAnswerErrorMsg          = "Invalid Answer"
+	invalidImportErrorMsg          = "Invalid Import"
+	invalidRevisionErrorMsg        = "Invalid Revision"
+	duplicateJobErrorMsg           = "Duplicate Job"
+	invalidCityErrorMsg            = "Invalid City"
+	invalidLocationErrorMsg        = "Invalid Location"
+	invalidLanguageErrorMsg        = "Invalid Language"
+	invalidTranslationErrorMsg     = "Invalid Translation"
+	invalidRoleErrorMsg            = "Invalid Role"
+	invalidReasonErrorMsg          = "Invalid Reason"
+	invalidReportErrorMsg          = "Invalid Report"
+	duplicateReportErrorMsg        = "Duplicate Report"
+	invalidStatusErrorMsg          = "Invalid Status"
+	invalidNoteErrorMsg            = "Invalid Note"
+	reapplyNotAllowedErrorMsg      = "Reapply Not Allowed"
+	invalidAttachmentErrorMsg      = "Invalid Attachment"
+	invalidRequiredFieldErrorMsg   = "Invalid Required Field"
+	invalidCoverLetterErrorMsg     = "Invalid Cover Letter"
+	invalidExpectedSalaryErrorMsg  = "Invalid Expected Salary"
+	invalidAvailableFromErrorMsg   = "Invalid Available From"
+	invalidLinkErrorMsg            = "Invalid Link"
+	fileTooLargeErrorMsg           = "File Too Large"
+	unsupportedFileTypeErrorMsg    = "Unsupported File Type"
+	infectedFileErrorMsg           = "Infected File"
+	invalidSignatureErrorMsg       = "Invalid Signature"
+	expiredLinkErrorMsg            = "Expired Link"
+	invalidTagErrorMsg             = "Invalid Tag"
+	invalidScorecardErrorMsg       = "Invalid Scorecard"
+	invalidScoreErrorMsg           = "Invalid Score"
+
+	invalidInterviewSlotErrorMsg     = "Invalid Interview Slot"
+	interviewSlotConflictErrorMsg    = "Interview Slot Conflict"
+	interviewSlotUnavailableErrorMsg = "Interview Slot Unavailable"
+	notInInterviewErrorMsg           = "Application Not In Interview"
+
+	maxImportSize = 5 << 20
+
+	// Uploads are checked against the configured limit by the usecase. The
+	// request itself may be larger by the multipart overhead, beyond which it
+	// isn't read at all.
+	maxMultipartOverhead = 1 << 20
+	maxUploadMemory      = 1 << 20
+
+	attachmentCacheControl = "private, no-store"
+
+	interviewInviteFileName = "interview.ics"
+
+	feedCacheControl = "public, max-age=300"
 )
 
 type controllerImpl struct {
-	userUsecase user.UserUsecase
-	jobUsecase  job.JobUsecase
+	userUsecase       user.UserUsecase
+	jobUsecase        job.JobUsecase
+	companyUsecase    company.CompanyUsecase
+	feedUsecase       feed.FeedUsecase
+	attachmentUsecase attachment.AttachmentUsecase
+	profileUsecase    profile.ProfileUsecase
 }
 
-func NewControllerImpl(userUsecase user.UserUsecase, jobUsecase job.JobUsecase) Controller {
+func NewControllerImpl(userUsecase user.UserUsecase, jobUsecase job.JobUsecase, companyUsecase company.CompanyUsecase, feedUsecase feed.FeedUsecase, attachmentUsecase attachment.AttachmentUsecase, profileUsecase profile.ProfileUsecase) Controller {
 	return &controllerImpl{
-		userUsecase: userUsecase,
-		jobUsecase:  jobUsecase,
+		userUsecase:       userUsecase,
+		jobUsecase:        jobUsecase,
+		companyUsecase:    companyUsecase,
+		feedUsecase:       feedUsecase,
+		attachmentUsecase: attachmentUsecase,
+		profileUsecase:    profileUsecase,
 	}
 }
 
@@ -52,6 +132,76 @@ func parseHeader(authorizationHeader string) (accessToken string, claims jwt.Map
 	return accessToken, claims, nil
 }
 
+func paginatedResponse(items interface{}, page, limit, total int) response.PaginatedResponse {
+	return response.PaginatedResponse{
+		Items: items,
+		Page:  page,
+		Limit: limit,
+		Total: total,
+	}
+}
+
+// importFormatFromContentType maps the upload's media type to an import format,
+// returning an empty format when it is not recognised.
+func importFormatFromContentType(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return ""
+	}
+
+	switch mediaType {
+	case "text/csv", "application/csv":
+		return enum.ImportFormatCSV
+	case "application/x-ndjson", "application/jsonl", "application/x-jsonlines":
+		return enum.ImportFormatJSONL
+	}
+	return ""
+}
+
+// etagMatches reports whether the If-None-Match header lists etag, ignoring
+// the weak validator prefix as RFC 9110 asks for this comparison.
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// languagePreferences returns the languages the client wants jobs in. The
+// lang query parameter takes precedence over the Accept-Language header.
+func languagePreferences(r *http.Request) []string {
+	if lang := r.URL.Query().Get("lang"); lang != "" {
+		return []string{lang}
+	}
+	return locale.ParseAcceptLanguage(r.Header.Get("Accept-Language"))
+}
+
+func parsePagination(r *http.Request) (page, limit int, err error) {
+	page, limit = enum.DefaultPage, enum.DefaultLimit
+
+	if pageStr := r.URL.Query().Get("page"); pageStr != "" {
+		page, err = strconv.Atoi(pageStr)
+		if err != nil || page < 1 {
+			return 0, 0, errors.New(invalidPaginationErrorMsg)
+		}
+	}
+
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil || limit < 1 {
+			return 0, 0, errors.New(invalidPaginationErrorMsg)
+		}
+	}
+
+	if limit > enum.MaxLimit {
+		limit = enum.MaxLimit
+	}
+	return page, limit, nil
+}
+
 // @Summary Ping endpoint
 // @Description Used for Health Check"
 // @Tags Ping
@@ -128,6 +278,11 @@ func (c *controllerImpl) Register(w http.ResponseWriter, r *http.Request) {
 
 	accessToken, refreshToken, err := c.userUsecase.Register(ctx, req)
 	if err != nil {
+		if err == user.ErrInvalidRole {
+			response.Message = invalidRoleErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
 		if strings.Contains(err.Error(), "unique constraint") {
 			setResponse(w, http.StatusConflict, response)
 			return
@@ -247,10 +402,17 @@ func (c *controllerImpl) RefreshToken(w http.ResponseWriter, r *http.Request) {
 }
 
 // @Summary Get all jobs
-// @Description Retrieves all jobs
+// @Description Retrieves all jobs, optionally filtered by skill tags and by distance from a point
 // @Tags Job
 // @Produce json
+// @Param skills query string false "Comma separated skill tags, jobs must have all of them"
+// @Param lat query number false "Latitude to search around"
+// @Param lon query number false "Longitude to search around"
+// @Param radius_km query number false "Search radius in kilometres, 25 by default"
+// @Param sort query string false "Set to distance to sort by distance"
+// @Param lang query string false "Preferred language, overrides the Accept-Language header"
 // @Success 200 {array} model.Job
+// @Failure 400 {object} response.ReadResponse "Bad Request"
 // @Failure 401 {object} response.WriteResponse "Unauthorized"
 // @Failure 404 {object} response.ReadResponse "Not Found"
 // @Failure 500 {object} response.ReadResponse
@@ -258,11 +420,49 @@ func (c *controllerImpl) RefreshToken(w http.ResponseWriter, r *http.Request) {
 func (c *controllerImpl) GetAllJob(w http.ResponseWriter, r *http.Request) {
 	requestTime := time.Now().Format(time.RFC3339)
 	ctx := r.Context()
+	req := request.SearchJobRequest{}
 	response := response.ReadResponse{}
 	response.Time = requestTime
 
-	jobs, err := c.jobUsecase.GetAllJob(ctx)
+	query := r.URL.Query()
+	if skills := query.Get("skills"); skills != "" {
+		req.Skills = strings.Split(skills, ",")
+	}
+	req.Sort = query.Get("sort")
+	req.Languages = languagePreferences(r)
+
+	for name, dest := range map[string]**float64{"lat": &req.Latitude, "lon": &req.Longitude, "radius_km": &req.RadiusKm} {
+		value := query.Get(name)
+		if value == "" {
+			continue
+		}
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			response.Message = invalidLocationErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		*dest = &number
+	}
+
+	w.Header().Set("Vary", "Accept-Language")
+	jobs, err := c.jobUsecase.GetAllJob(ctx, req)
 	if err != nil {
+		if err == job.ErrInvalidSkill {
+			response.Message = invalidSkillErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == job.ErrInvalidLocation {
+			response.Message = invalidLocationErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == job.ErrInvalidSort {
+			response.Message = invalidSortErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
 		response.Message = internalServerErrorMsg
 		setResponse(w, http.StatusInternalServerError, response)
 		return
@@ -279,10 +479,11 @@ func (c *controllerImpl) GetAllJob(w http.ResponseWriter, r *http.Request) {
 }
 
 // @Summary Get a job by ID
-// @Description Retrieves a job by ID
+// @Description Retrieves a job by ID in the language best matching the lang parameter or the Accept-Language header
 // @Tags Job
 // @Produce json
 // @Param jobId path int true "Job ID"
+// @Param lang query string false "Preferred language, overrides the Accept-Language header"
 // @Success 200 {object} response.ReadResponse
 // @Failure 400 {object} response.ReadResponse "Bad Request"
 // @Failure 401 {object} response.WriteResponse "Unauthorized"
@@ -306,6 +507,8 @@ func (c *controllerImpl) GetJobById(w http.ResponseWriter, r *http.Request) {
 	}
 
 	req.JobId = jobId
+	req.Languages = languagePreferences(r)
+	w.Header().Set("Vary", "Accept-Language")
 	jobs, err := c.jobUsecase.GetJobById(ctx, req)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -320,24 +523,26 @@ func (c *controllerImpl) GetJobById(w http.ResponseWriter, r *http.Request) {
 
 	response.Message = ""
 	response.Data = jobs
+	w.Header().Set("Content-Language", jobs.Language)
 	setResponse(w, http.StatusOK, response)
 }
 
 // @Summary Insert a new job
-// @Description Inserts a new job into the database
+// @Description Inserts a new job into the database. Likely duplicates of the employer's recent postings are warned about or rejected, and postings caught by the spam filter are held for moderation.
 // @Tags Job
 // @Produce json
 // @Param job body request.InsertJobRequest true "Job data"
-// @Success 200 {object} response.WriteResponse
-// @Failure 400 {object} response.WriteResponse "Bad Request"
-// @Failure 401 {object} response.WriteResponse "Unauthorized"
-// @Failure 500 {object} response.WriteResponse "Internal Server Error"
+// @Success 200 {object} response.ReadResponse{data=model.InsertJobResult}
+// @Failure 400 {object} response.ReadResponse "Bad Request"
+// @Failure 401 {object} response.ReadResponse "Unauthorized"
+// @Failure 409 {object} response.ReadResponse "Duplicate Job"
+// @Failure 500 {object} response.ReadResponse "Internal Server Error"
 // @Router /job [post]
 func (c *controllerImpl) InsertJob(w http.ResponseWriter, r *http.Request) {
 	requestTime := time.Now().Format(time.RFC3339)
 	ctx := r.Context()
 	req := request.InsertJobRequest{}
-	response := response.WriteResponse{}
+	response := response.ReadResponse{}
 	response.Time = requestTime
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -354,37 +559,100 @@ func (c *controllerImpl) InsertJob(w http.ResponseWriter, r *http.Request) {
 	}
 
 	req.EmployerId = int(claims["sub"].(float64))
-	err = c.jobUsecase.InsertJob(ctx, req)
+	result, err := c.jobUsecase.InsertJob(ctx, req)
 	if err != nil {
+		if err == job.ErrDuplicateJob {
+			response.Message = duplicateJobErrorMsg
+			setResponse(w, http.StatusConflict, response)
+			return
+		}
+		if err == job.ErrInvalidDescription {
+			response.Message = invalidDescriptionErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == job.ErrInvalidRequirement {
+			response.Message = invalidRequirementErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == job.ErrInvalidSkill {
+			response.Message = invalidSkillErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == job.ErrInvalidExpireDate {
+			response.Message = invalidExpireDateErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == job.ErrInvalidOpenings {
+			response.Message = invalidOpeningsErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == job.ErrInvalidMaxApplications {
+			response.Message = invalidMaxApplicationsErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == job.ErrInvalidRequiredField {
+			response.Message = invalidRequiredFieldErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == job.ErrInvalidCity {
+			response.Message = invalidCityErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == job.ErrInvalidLanguage {
+			response.Message = invalidLanguageErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == job.ErrInvalidTranslation {
+			response.Message = invalidTranslationErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == job.ErrInvalidQuestion {
+			response.Message = invalidQuestionErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
 		response.Message = internalServerErrorMsg
 		setResponse(w, http.StatusInternalServerError, response)
 		return
 	}
 	response.Message = ""
+	response.Data = result
 	setResponse(w, http.StatusOK, response)
 }
 
-// @Summary Get applications by Job ID
-// @Description Retrieves applications for a specific job
-// @Tags Application
+// @Summary Import jobs in bulk
+// @Description Imports jobs from a CSV or JSON Lines file. Small files are imported right away, larger ones in the background.
+// @Tags Job
+// @Accept text/csv,application/x-ndjson
 // @Produce json
-// @Param jobId path int true "Job ID"
+// @Param format query string false "File format (csv or jsonl), defaults to the Content-Type"
+// @Param mode query string false "Import mode (atomic or partial)"
 // @Success 200 {object} response.ReadResponse
+// @Success 202 {object} response.ReadResponse
 // @Failure 400 {object} response.ReadResponse "Bad Request"
 // @Failure 401 {object} response.ReadResponse "Unauthorized"
 // @Failure 500 {object} response.ReadResponse "Internal Server Error"
-// @Router /job/{jobId}/applications [get]
-func (c *controllerImpl) GetApplicationsByJobId(w http.ResponseWriter, r *http.Request) {
+// @Router /jobs/import [post]
+func (c *controllerImpl) ImportJobs(w http.ResponseWriter, r *http.Request) {
 	requestTime := time.Now().Format(time.RFC3339)
 	ctx := r.Context()
-	req := request.SearchApplicationByJobRequest{}
+	req := request.ImportJobRequest{}
 	response := response.ReadResponse{}
 	response.Time = requestTime
 
-	jobIdStr := chi.URLParam(r, "jobId")
-	jobId, err := strconv.Atoi(jobIdStr)
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxImportSize))
 	if err != nil {
-		response.Message = ""
+		response.Message = err.Error()
 		setResponse(w, http.StatusBadRequest, response)
 		return
 	}
@@ -396,40 +664,55 @@ func (c *controllerImpl) GetApplicationsByJobId(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	req.JobId = jobId
 	req.EmployerId = int(claims["sub"].(float64))
-	jobs, err := c.jobUsecase.GetApplicationsByJobId(ctx, req)
+	req.Format = strings.ToLower(r.URL.Query().Get("format"))
+	if req.Format == "" {
+		req.Format = importFormatFromContentType(r.Header.Get("Content-Type"))
+	}
+	req.Mode = strings.ToLower(r.URL.Query().Get("mode"))
+	req.Data = data
+
+	jobImport, err := c.jobUsecase.ImportJobs(ctx, req)
 	if err != nil {
+		if err == job.ErrInvalidImport {
+			response.Message = invalidImportErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
 		response.Message = internalServerErrorMsg
 		setResponse(w, http.StatusInternalServerError, response)
 		return
 	}
 
 	response.Message = ""
-	response.Data = jobs
+	response.Data = jobImport
+	if jobImport.ImportStatus == enum.PendingImportStatus {
+		setResponse(w, http.StatusAccepted, response)
+		return
+	}
 	setResponse(w, http.StatusOK, response)
 }
 
-// @Summary Get an application by ID
-// @Description Retrieves an application by ID
-// @Tags Application
+// @Summary Get a job import
+// @Description Retrieves the status and per-row report of a job import
+// @Tags Job
 // @Produce json
-// @Param applicationId path int true "Application ID"
+// @Param importId path int true "Import ID"
 // @Success 200 {object} response.ReadResponse
 // @Failure 400 {object} response.ReadResponse "Bad Request"
 // @Failure 401 {object} response.ReadResponse "Unauthorized"
 // @Failure 404 {object} response.ReadResponse "Not Found"
 // @Failure 500 {object} response.ReadResponse "Internal Server Error"
-// @Router /application/{applicationId} [get]
-func (c *controllerImpl) GetApplicationById(w http.ResponseWriter, r *http.Request) {
+// @Router /jobs/import/{importId} [get]
+func (c *controllerImpl) GetJobImport(w http.ResponseWriter, r *http.Request) {
 	requestTime := time.Now().Format(time.RFC3339)
 	ctx := r.Context()
-	req := request.SearchApplicationByIdRequest{}
+	req := request.SearchJobImportRequest{}
 	response := response.ReadResponse{}
 	response.Time = requestTime
 
-	applicationIdStr := chi.URLParam(r, "applicationId")
-	applicationId, err := strconv.Atoi(applicationIdStr)
+	importIdStr := chi.URLParam(r, "importId")
+	importId, err := strconv.Atoi(importIdStr)
 	if err != nil {
 		response.Message = ""
 		setResponse(w, http.StatusBadRequest, response)
@@ -443,10 +726,9 @@ func (c *controllerImpl) GetApplicationById(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	req.ApplicationId = applicationId
-	req.UserId = int(claims["sub"].(float64))
-	req.Role = int(claims["rle"].(float64))
-	jobs, err := c.jobUsecase.GetApplicationById(ctx, req)
+	req.ImportId = importId
+	req.EmployerId = int(claims["sub"].(float64))
+	jobImport, err := c.jobUsecase.GetJobImport(ctx, req)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			response.Message = notFoundErrorMsg
@@ -459,26 +741,27 @@ func (c *controllerImpl) GetApplicationById(w http.ResponseWriter, r *http.Reque
 	}
 
 	response.Message = ""
-	response.Data = jobs
+	response.Data = jobImport
 	setResponse(w, http.StatusOK, response)
 }
 
-// @Summary Apply for the Job
-// @Description Inserts a new application into the database
+// @Summary Edit a job
+// @Description Updates a job owned by the employer and records the result as a new revision
 // @Tags Job
+// @Accept json
 // @Produce json
 // @Param jobId path int true "Job ID"
+// @Param request body request.UpdateJobRequest true "Update Job Request"
 // @Success 200 {object} response.WriteResponse
 // @Failure 400 {object} response.WriteResponse "Bad Request"
 // @Failure 401 {object} response.WriteResponse "Unauthorized"
 // @Failure 404 {object} response.WriteResponse "Not Found"
-// @Failure 409 {object} response.WriteResponse "Conflict"
 // @Failure 500 {object} response.WriteResponse "Internal Server Error"
-// @Router /job/{jobId} [post]
-func (c *controllerImpl) InsertApplication(w http.ResponseWriter, r *http.Request) {
+// @Router /job/{jobId} [put]
+func (c *controllerImpl) UpdateJob(w http.ResponseWriter, r *http.Request) {
 	requestTime := time.Now().Format(time.RFC3339)
 	ctx := r.Context()
-	req := request.InsertApplicationRequest{}
+	req := request.UpdateJobRequest{}
 	response := response.WriteResponse{}
 	response.Time = requestTime
 
@@ -490,6 +773,12 @@ func (c *controllerImpl) InsertApplication(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Message = err.Error()
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
 	_, claims, err := parseHeader(r.Header.Get("Authorization"))
 	if err != nil {
 		response.Message = unableToParseTokenErrorMsg
@@ -498,14 +787,61 @@ func (c *controllerImpl) InsertApplication(w http.ResponseWriter, r *http.Reques
 	}
 
 	req.JobId = jobId
-	req.TalentId = int(claims["sub"].(float64))
-	err = c.jobUsecase.InsertApplication(ctx, req)
+	req.EmployerId = int(claims["sub"].(float64))
+	err = c.jobUsecase.UpdateJob(ctx, req)
 	if err != nil {
-		if strings.Contains(err.Error(), "unique constraint") {
-			setResponse(w, http.StatusConflict, response)
+		if err == job.ErrInvalidDescription {
+			response.Message = invalidDescriptionErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
 			return
 		}
-		if strings.Contains(err.Error(), "foreign key constraint") {
+		if err == job.ErrInvalidRequirement {
+			response.Message = invalidRequirementErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == job.ErrInvalidSkill {
+			response.Message = invalidSkillErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == job.ErrInvalidExpireDate {
+			response.Message = invalidExpireDateErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == job.ErrInvalidOpenings {
+			response.Message = invalidOpeningsErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == job.ErrInvalidMaxApplications {
+			response.Message = invalidMaxApplicationsErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == job.ErrInvalidRequiredField {
+			response.Message = invalidRequiredFieldErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == job.ErrInvalidCity {
+			response.Message = invalidCityErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == job.ErrInvalidLanguage {
+			response.Message = invalidLanguageErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == job.ErrInvalidTranslation {
+			response.Message = invalidTranslationErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == sql.ErrNoRows {
+			response.Message = notFoundErrorMsg
 			setResponse(w, http.StatusNotFound, response)
 			return
 		}
@@ -518,39 +854,32 @@ func (c *controllerImpl) InsertApplication(w http.ResponseWriter, r *http.Reques
 	setResponse(w, http.StatusOK, response)
 }
 
-// @Summary Update the status of an application
-// @Description Updates the status of an application in the database
-// @Tags Application
+// @Summary Get job revisions
+// @Description Retrieves every revision of a job, oldest first. Available to the employer owning the job and to talents who applied to it.
+// @Tags Job
 // @Produce json
-// @Param applicationId path int true "Application ID"
-// @Param request body request.UpdateApplicationStatusRequest true "Update Application Status Request"
-// @Success 200 {object} response.WriteResponse
-// @Failure 400 {object} response.WriteResponse "Bad Request"
-// @Failure 401 {object} response.WriteResponse "Unauthorized"
-// @Failure 404 {object} response.WriteResponse "Not Found"
-// @Failure 500 {object} response.WriteResponse "Internal Server Error"
-// @Router /application/{applicationId} [put]
-func (c *controllerImpl) UpdateApplicationStatus(w http.ResponseWriter, r *http.Request) {
+// @Param jobId path int true "Job ID"
+// @Success 200 {object} response.ReadResponse{data=[]model.JobRevision}
+// @Failure 400 {object} response.ReadResponse "Bad Request"
+// @Failure 401 {object} response.ReadResponse "Unauthorized"
+// @Failure 404 {object} response.ReadResponse "Not Found"
+// @Failure 500 {object} response.ReadResponse "Internal Server Error"
+// @Router /job/{jobId}/revisions [get]
+func (c *controllerImpl) GetJobRevisions(w http.ResponseWriter, r *http.Request) {
 	requestTime := time.Now().Format(time.RFC3339)
 	ctx := r.Context()
-	req := request.UpdateApplicationStatusRequest{}
-	response := response.WriteResponse{}
+	req := request.SearchJobRevisionRequest{}
+	response := response.ReadResponse{}
 	response.Time = requestTime
 
-	applicationIdStr := chi.URLParam(r, "applicationId")
-	applicationId, err := strconv.Atoi(applicationIdStr)
+	jobIdStr := chi.URLParam(r, "jobId")
+	jobId, err := strconv.Atoi(jobIdStr)
 	if err != nil {
 		response.Message = ""
 		setResponse(w, http.StatusBadRequest, response)
 		return
 	}
 
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		response.Message = err.Error()
-		setResponse(w, http.StatusBadRequest, response)
-		return
-	}
-
 	_, claims, err := parseHeader(r.Header.Get("Authorization"))
 	if err != nil {
 		response.Message = unableToParseTokenErrorMsg
@@ -558,29 +887,2878 @@ func (c *controllerImpl) UpdateApplicationStatus(w http.ResponseWriter, r *http.
 		return
 	}
 
-	req.ApplicationId = applicationId
-	req.EmployerId = int(claims["sub"].(float64))
-	err = c.jobUsecase.UpdateApplicationStatus(ctx, req)
+	req.JobId = jobId
+	req.UserId = int(claims["sub"].(float64))
+	req.Role = int(claims["rle"].(float64))
+	revisions, err := c.jobUsecase.GetJobRevisions(ctx, req)
 	if err != nil {
-		if strings.Contains(err.Error(), "Unauthorized") {
-			setResponse(w, http.StatusNotFound, response)
-			return
-		}
-		if strings.Contains(err.Error(), "Invalid Status") {
-			setResponse(w, http.StatusBadRequest, response)
-			return
-		}
 		if err == sql.ErrNoRows {
 			response.Message = notFoundErrorMsg
 			setResponse(w, http.StatusNotFound, response)
 			return
 		}
-
 		response.Message = internalServerErrorMsg
 		setResponse(w, http.StatusInternalServerError, response)
 		return
 	}
 
 	response.Message = ""
+	response.Data = revisions
 	setResponse(w, http.StatusOK, response)
 }
+
+// @Summary Diff two job revisions
+// @Description Compares two revisions of a job field by field, line by line
+// @Tags Job
+// @Produce json
+// @Param jobId path int true "Job ID"
+// @Param from query int true "Revision to compare from"
+// @Param to query int true "Revision to compare to"
+// @Success 200 {object} response.ReadResponse{data=model.JobRevisionDiff}
+// @Failure 400 {object} response.ReadResponse "Bad Request"
+// @Failure 401 {object} response.ReadResponse "Unauthorized"
+// @Failure 404 {object} response.ReadResponse "Not Found"
+// @Failure 500 {object} response.ReadResponse "Internal Server Error"
+// @Router /job/{jobId}/revisions/diff [get]
+func (c *controllerImpl) GetJobRevisionDiff(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.SearchJobRevisionDiffRequest{}
+	response := response.ReadResponse{}
+	response.Time = requestTime
+
+	jobIdStr := chi.URLParam(r, "jobId")
+	jobId, err := strconv.Atoi(jobIdStr)
+	if err != nil {
+		response.Message = ""
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	fromRevision, err := strconv.Atoi(r.URL.Query().Get("from"))
+	if err != nil {
+		response.Message = invalidRevisionErrorMsg
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	toRevision, err := strconv.Atoi(r.URL.Query().Get("to"))
+	if err != nil {
+		response.Message = invalidRevisionErrorMsg
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.JobId = jobId
+	req.UserId = int(claims["sub"].(float64))
+	req.Role = int(claims["rle"].(float64))
+	req.FromRevision = fromRevision
+	req.ToRevision = toRevision
+	revisionDiff, err := c.jobUsecase.GetJobRevisionDiff(ctx, req)
+	if err != nil {
+		if err == job.ErrInvalidRevision {
+			response.Message = invalidRevisionErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == sql.ErrNoRows {
+			response.Message = notFoundErrorMsg
+			setResponse(w, http.StatusNotFound, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	response.Data = revisionDiff
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Update the status of a job
+// @Description Opens or closes a job owned by the employer
+// @Tags Job
+// @Accept json
+// @Produce json
+// @Param jobId path int true "Job ID"
+// @Param request body request.UpdateJobStatusRequest true "Update Job Status Request"
+// @Success 200 {object} response.WriteResponse
+// @Failure 400 {object} response.WriteResponse "Bad Request"
+// @Failure 401 {object} response.WriteResponse "Unauthorized"
+// @Failure 404 {object} response.WriteResponse "Not Found"
+// @Failure 500 {object} response.WriteResponse "Internal Server Error"
+// @Router /job/{jobId}/status [put]
+func (c *controllerImpl) UpdateJobStatus(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.UpdateJobStatusRequest{}
+	response := response.WriteResponse{}
+	response.Time = requestTime
+
+	jobIdStr := chi.URLParam(r, "jobId")
+	jobId, err := strconv.Atoi(jobIdStr)
+	if err != nil {
+		response.Message = ""
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Message = err.Error()
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.JobId = jobId
+	req.EmployerId = int(claims["sub"].(float64))
+	err = c.jobUsecase.UpdateJobStatus(ctx, req)
+	if err != nil {
+		if err == job.ErrInvalidJobStatus {
+			response.Message = invalidJobStatusErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == sql.ErrNoRows {
+			response.Message = notFoundErrorMsg
+			setResponse(w, http.StatusNotFound, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Get employer jobs
+// @Description Retrieves the jobs posted by the employer with application counts per status
+// @Tags Job
+// @Produce json
+// @Param page query int false "Page number"
+// @Param limit query int false "Page size"
+// @Param sort_by query string false "Sort field (create_date, title, applications)"
+// @Param sort_order query string false "Sort order (asc, desc)"
+// @Success 200 {object} response.ReadResponse{data=response.PaginatedResponse{items=[]model.EmployerJob}}
+// @Failure 400 {object} response.ReadResponse "Bad Request"
+// @Failure 401 {object} response.ReadResponse "Unauthorized"
+// @Failure 500 {object} response.ReadResponse "Internal Server Error"
+// @Router /employer/jobs [get]
+func (c *controllerImpl) GetJobsByEmployerId(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.SearchJobByEmployerRequest{}
+	response := response.ReadResponse{}
+	response.Time = requestTime
+
+	page, limit, err := parsePagination(r)
+	if err != nil {
+		response.Message = err.Error()
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.EmployerId = int(claims["sub"].(float64))
+	req.Page = page
+	req.Limit = limit
+	req.SortBy = r.URL.Query().Get("sort_by")
+	req.SortOrder = r.URL.Query().Get("sort_order")
+	jobs, total, err := c.jobUsecase.GetJobsByEmployerId(ctx, req)
+	if err != nil {
+		if err == job.ErrInvalidSort {
+			response.Message = invalidSortErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	response.Data = paginatedResponse(jobs, page, limit, total)
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Autocomplete skills
+// @Description Retrieves skills whose name or alias starts with the query, most used first
+// @Tags Skill
+// @Produce json
+// @Param q query string false "Skill prefix"
+// @Param limit query int false "Maximum number of skills"
+// @Success 200 {object} response.ReadResponse{data=[]model.Skill}
+// @Failure 400 {object} response.ReadResponse "Bad Request"
+// @Failure 401 {object} response.ReadResponse "Unauthorized"
+// @Failure 500 {object} response.ReadResponse "Internal Server Error"
+// @Router /skills [get]
+func (c *controllerImpl) SearchSkills(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.SearchSkillRequest{}
+	response := response.ReadResponse{}
+	response.Time = requestTime
+
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil {
+			response.Message = invalidPaginationErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		req.Limit = limit
+	}
+
+	req.Query = r.URL.Query().Get("q")
+	skills, err := c.jobUsecase.SearchSkills(ctx, req)
+	if err != nil {
+		if err == job.ErrInvalidSkill {
+			response.Message = invalidSkillErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	response.Data = skills
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Get applications by Job ID
+// @Description Retrieves applications for a specific job. Withdrawn applications are left out unless include_withdrawn is set.
+// @Tags Application
+// @Produce json
+// @Param jobId path int true "Job ID"
+// @Param include_withdrawn query bool false "Include withdrawn applications"
+// @Success 200 {object} response.ReadResponse
+// @Failure 400 {object} response.ReadResponse "Bad Request"
+// @Failure 401 {object} response.ReadResponse "Unauthorized"
+// @Failure 500 {object} response.ReadResponse "Internal Server Error"
+// @Router /job/{jobId}/applications [get]
+func (c *controllerImpl) GetApplicationsByJobId(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.SearchApplicationByJobRequest{}
+	response := response.ReadResponse{}
+	response.Time = requestTime
+
+	jobIdStr := chi.URLParam(r, "jobId")
+	jobId, err := strconv.Atoi(jobIdStr)
+	if err != nil {
+		response.Message = ""
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	if includeWithdrawn := r.URL.Query().Get("include_withdrawn"); includeWithdrawn != "" {
+		req.IncludeWithdrawn, err = strconv.ParseBool(includeWithdrawn)
+		if err != nil {
+			response.Message = err.Error()
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+	}
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.JobId = jobId
+	req.EmployerId = int(claims["sub"].(float64))
+	jobs, err := c.jobUsecase.GetApplicationsByJobId(ctx, req)
+	if err != nil {
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	response.Data = jobs
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Get an application by ID
+// @Description Retrieves an application by ID
+// @Tags Application
+// @Produce json
+// @Param applicationId path int true "Application ID"
+// @Success 200 {object} response.ReadResponse
+// @Failure 400 {object} response.ReadResponse "Bad Request"
+// @Failure 401 {object} response.ReadResponse "Unauthorized"
+// @Failure 404 {object} response.ReadResponse "Not Found"
+// @Failure 500 {object} response.ReadResponse "Internal Server Error"
+// @Router /application/{applicationId} [get]
+func (c *controllerImpl) GetApplicationById(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.SearchApplicationByIdRequest{}
+	response := response.ReadResponse{}
+	response.Time = requestTime
+
+	applicationIdStr := chi.URLParam(r, "applicationId")
+	applicationId, err := strconv.Atoi(applicationIdStr)
+	if err != nil {
+		response.Message = ""
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.ApplicationId = applicationId
+	req.UserId = int(claims["sub"].(float64))
+	req.Role = int(claims["rle"].(float64))
+	jobs, err := c.jobUsecase.GetApplicationById(ctx, req)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			response.Message = notFoundErrorMsg
+			setResponse(w, http.StatusNotFound, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	response.Data = jobs
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Get my applications
+// @Description Retrieves the talent's applications, newest first, with a summary of every job
+// @Tags Application
+// @Produce json
+// @Param status query string false "Comma separated application statuses: 1 created, 2 interview, 3 accepted, 4 declined, 5 withdrawn"
+// @Param page query int false "Page number"
+// @Param limit query int false "Page size"
+// @Success 200 {object} response.ReadResponse{data=response.PaginatedResponse{items=[]model.Application}}
+// @Failure 400 {object} response.ReadResponse "Bad Request"
+// @Failure 401 {object} response.ReadResponse "Unauthorized"
+// @Failure 500 {object} response.ReadResponse "Internal Server Error"
+// @Router /me/applications [get]
+func (c *controllerImpl) GetMyApplications(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.SearchApplicationByTalentRequest{}
+	response := response.ReadResponse{}
+	response.Time = requestTime
+
+	page, limit, err := parsePagination(r)
+	if err != nil {
+		response.Message = err.Error()
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	if statuses := r.URL.Query().Get("status"); statuses != "" {
+		for _, statusStr := range strings.Split(statuses, ",") {
+			status, err := strconv.Atoi(strings.TrimSpace(statusStr))
+			if err != nil {
+				response.Message = invalidStatusErrorMsg
+				setResponse(w, http.StatusBadRequest, response)
+				return
+			}
+			req.Statuses = append(req.Statuses, status)
+		}
+	}
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.TalentId = int(claims["sub"].(float64))
+	req.Page = page
+	req.Limit = limit
+	applications, total, err := c.jobUsecase.GetApplicationsByTalentId(ctx, req)
+	if err != nil {
+		if err == job.ErrInvalidStatus {
+			response.Message = invalidStatusErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	response.Data = paginatedResponse(applications, page, limit, total)
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Apply for the Job
+// @Description Inserts a new application into the database
+// @Tags Job
+// @Produce json
+// @Param jobId path int true "Job ID"
+// @Param request body request.InsertApplicationRequest false "Application Fields, Screening Question Answers and Attachments"
+// @Success 200 {object} response.WriteResponse
+// @Failure 400 {object} response.WriteResponse "Bad Request"
+// @Failure 401 {object} response.WriteResponse "Unauthorized"
+// @Failure 404 {object} response.WriteResponse "Not Found"
+// @Failure 409 {object} response.WriteResponse "Conflict"
+// @Failure 500 {object} response.WriteResponse "Internal Server Error"
+// @Router /job/{jobId} [post]
+func (c *controllerImpl) InsertApplication(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.InsertApplicationRequest{}
+	response := response.WriteResponse{}
+	response.Time = requestTime
+
+	jobIdStr := chi.URLParam(r, "jobId")
+	jobId, err := strconv.Atoi(jobIdStr)
+	if err != nil {
+		response.Message = ""
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	// The body is optional; jobs without screening questions or required
+	// fields take none.
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && err != io.EOF {
+		response.Message = err.Error()
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.JobId = jobId
+	req.TalentId = int(claims["sub"].(float64))
+	err = c.jobUsecase.InsertApplication(ctx, req)
+	if err != nil {
+		if err == job.ErrJobClosed {
+			response.Message = jobClosedErrorMsg
+			setResponse(w, http.StatusConflict, response)
+			return
+		}
+		if err == job.ErrReapplyNotAllowed {
+			response.Message = reapplyNotAllowedErrorMsg
+			setResponse(w, http.StatusConflict, response)
+			return
+		}
+		var missingFieldErr *job.MissingFieldError
+		if errors.As(err, &missingFieldErr) {
+			response.Message = missingFieldErr.Error()
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == job.ErrInvalidCoverLetter {
+			response.Message = invalidCoverLetterErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == job.ErrInvalidExpectedSalary {
+			response.Message = invalidExpectedSalaryErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == job.ErrInvalidAvailableFrom {
+			response.Message = invalidAvailableFromErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == job.ErrInvalidLink {
+			response.Message = invalidLinkErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == job.ErrInvalidAttachment {
+			response.Message = invalidAttachmentErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == job.ErrInvalidAnswer {
+			response.Message = invalidAnswerErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == sql.ErrNoRows {
+			response.Message = notFoundErrorMsg
+			setResponse(w, http.StatusNotFound, response)
+			return
+		}
+		if strings.Contains(err.Error(), "unique constraint") {
+			setResponse(w, http.StatusConflict, response)
+			return
+		}
+		if strings.Contains(err.Error(), "foreign key constraint") {
+			setResponse(w, http.StatusNotFound, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Update the status of an application
+// @Description Moves an application to a new status and records the change with an optional note
+// @Tags Application
+// @Produce json
+// @Param applicationId path int true "Application ID"
+// @Param request body request.UpdateApplicationStatusRequest true "Update Application Status Request"
+// @Success 200 {object} response.WriteResponse
+// @Failure 400 {object} response.WriteResponse "Bad Request"
+// @Failure 401 {object} response.WriteResponse "Unauthorized"
+// @Failure 404 {object} response.WriteResponse "Not Found"
+// @Failure 409 {object} response.WriteResponse "Invalid Status Transition"
+// @Failure 500 {object} response.WriteResponse "Internal Server Error"
+// @Router /application/{applicationId} [put]
+func (c *controllerImpl) UpdateApplicationStatus(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.UpdateApplicationStatusRequest{}
+	response := response.WriteResponse{}
+	response.Time = requestTime
+
+	applicationIdStr := chi.URLParam(r, "applicationId")
+	applicationId, err := strconv.Atoi(applicationIdStr)
+	if err != nil {
+		response.Message = ""
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Message = err.Error()
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.ApplicationId = applicationId
+	req.EmployerId = int(claims["sub"].(float64))
+	err = c.jobUsecase.UpdateApplicationStatus(ctx, req)
+	if err != nil {
+		var transitionErr *job.InvalidTransitionError
+		if errors.As(err, &transitionErr) {
+			response.Message = transitionErr.Error()
+			setResponse(w, http.StatusConflict, response)
+			return
+		}
+		if strings.Contains(err.Error(), "Unauthorized") {
+			setResponse(w, http.StatusNotFound, response)
+			return
+		}
+		if err == job.ErrInvalidStatus {
+			response.Message = invalidStatusErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == job.ErrInvalidNote {
+			response.Message = invalidNoteErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == sql.ErrNoRows {
+			response.Message = notFoundErrorMsg
+			setResponse(w, http.StatusNotFound, response)
+			return
+		}
+
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Withdraw an application
+// @Description Withdraws one of the talent's applications that is still created or in interview
+// @Tags Application
+// @Accept json
+// @Produce json
+// @Param applicationId path int true "Application ID"
+// @Param request body request.WithdrawApplicationRequest false "Withdrawal Note"
+// @Success 200 {object} response.WriteResponse
+// @Failure 400 {object} response.WriteResponse "Bad Request"
+// @Failure 401 {object} response.WriteResponse "Unauthorized"
+// @Failure 404 {object} response.WriteResponse "Not Found"
+// @Failure 409 {object} response.WriteResponse "Invalid Status Transition"
+// @Failure 500 {object} response.WriteResponse "Internal Server Error"
+// @Router /application/{applicationId}/withdraw [post]
+func (c *controllerImpl) WithdrawApplication(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.WithdrawApplicationRequest{}
+	response := response.WriteResponse{}
+	response.Time = requestTime
+
+	applicationIdStr := chi.URLParam(r, "applicationId")
+	applicationId, err := strconv.Atoi(applicationIdStr)
+	if err != nil {
+		response.Message = ""
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	// The body is optional; a withdrawal doesn't need a note.
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && err != io.EOF {
+		response.Message = err.Error()
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.ApplicationId = applicationId
+	req.TalentId = int(claims["sub"].(float64))
+	err = c.jobUsecase.WithdrawApplication(ctx, req)
+	if err != nil {
+		var transitionErr *job.InvalidTransitionError
+		if errors.As(err, &transitionErr) {
+			response.Message = transitionErr.Error()
+			setResponse(w, http.StatusConflict, response)
+			return
+		}
+		if err == job.ErrInvalidNote {
+			response.Message = invalidNoteErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == sql.ErrNoRows {
+			response.Message = notFoundErrorMsg
+			setResponse(w, http.StatusNotFound, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Add a note to an application
+// @Description Adds a private note to an application to one of the employer's jobs; notes are never shown to the talent
+// @Tags Application
+// @Accept json
+// @Produce json
+// @Param applicationId path int true "Application ID"
+// @Param request body request.InsertApplicationNoteRequest true "Note"
+// @Success 200 {object} response.ReadResponse{data=model.ApplicationNote}
+// @Failure 400 {object} response.ReadResponse "Bad Request"
+// @Failure 401 {object} response.ReadResponse "Unauthorized"
+// @Failure 404 {object} response.ReadResponse "Not Found"
+// @Failure 500 {object} response.ReadResponse "Internal Server Error"
+// @Router /application/{applicationId}/notes [post]
+func (c *controllerImpl) InsertApplicationNote(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.InsertApplicationNoteRequest{}
+	response := response.ReadResponse{}
+	response.Time = requestTime
+
+	applicationIdStr := chi.URLParam(r, "applicationId")
+	applicationId, err := strconv.Atoi(applicationIdStr)
+	if err != nil {
+		response.Message = ""
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Message = err.Error()
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.ApplicationId = applicationId
+	req.EmployerId = int(claims["sub"].(float64))
+	note, err := c.jobUsecase.InsertApplicationNote(ctx, req)
+	if err != nil {
+		if err == job.ErrInvalidNote {
+			response.Message = invalidNoteErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == sql.ErrNoRows {
+			response.Message = notFoundErrorMsg
+			setResponse(w, http.StatusNotFound, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	response.Data = note
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Delete a note from an application
+// @Description Deletes one of the employer's own notes on an application
+// @Tags Application
+// @Produce json
+// @Param applicationId path int true "Application ID"
+// @Param noteId path int true "Note ID"
+// @Success 200 {object} response.WriteResponse
+// @Failure 400 {object} response.WriteResponse "Bad Request"
+// @Failure 401 {object} response.WriteResponse "Unauthorized"
+// @Failure 404 {object} response.WriteResponse "Not Found"
+// @Failure 500 {object} response.WriteResponse "Internal Server Error"
+// @Router /application/{applicationId}/notes/{noteId} [delete]
+func (c *controllerImpl) DeleteApplicationNote(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.DeleteApplicationNoteRequest{}
+	response := response.WriteResponse{}
+	response.Time = requestTime
+
+	applicationIdStr := chi.URLParam(r, "applicationId")
+	applicationId, err := strconv.Atoi(applicationIdStr)
+	if err != nil {
+		response.Message = ""
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	noteIdStr := chi.URLParam(r, "noteId")
+	noteId, err := strconv.Atoi(noteIdStr)
+	if err != nil {
+		response.Message = ""
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.ApplicationId = applicationId
+	req.NoteId = noteId
+	req.EmployerId = int(claims["sub"].(float64))
+	err = c.jobUsecase.DeleteApplicationNote(ctx, req)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			response.Message = notFoundErrorMsg
+			setResponse(w, http.StatusNotFound, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Update the tags of an application
+// @Description Replaces the private tags the employer has put on an application
+// @Tags Application
+// @Accept json
+// @Produce json
+// @Param applicationId path int true "Application ID"
+// @Param request body request.UpdateApplicationTagsRequest true "Tags"
+// @Success 200 {object} response.WriteResponse
+// @Failure 400 {object} response.WriteResponse "Bad Request"
+// @Failure 401 {object} response.WriteResponse "Unauthorized"
+// @Failure 404 {object} response.WriteResponse "Not Found"
+// @Failure 500 {object} response.WriteResponse "Internal Server Error"
+// @Router /application/{applicationId}/tags [put]
+func (c *controllerImpl) UpdateApplicationTags(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.UpdateApplicationTagsRequest{}
+	response := response.WriteResponse{}
+	response.Time = requestTime
+
+	applicationIdStr := chi.URLParam(r, "applicationId")
+	applicationId, err := strconv.Atoi(applicationIdStr)
+	if err != nil {
+		response.Message = ""
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Message = err.Error()
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.ApplicationId = applicationId
+	req.EmployerId = int(claims["sub"].(float64))
+	err = c.jobUsecase.UpdateApplicationTags(ctx, req)
+	if err != nil {
+		if err == job.ErrInvalidTag {
+			response.Message = invalidTagErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == sql.ErrNoRows {
+			response.Message = notFoundErrorMsg
+			setResponse(w, http.StatusNotFound, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Score an application
+// @Description Replaces the employer's scores for an application against the job scorecard, each from 1 to 5
+// @Tags Application
+// @Accept json
+// @Produce json
+// @Param applicationId path int true "Application ID"
+// @Param request body request.UpdateApplicationScorecardRequest true "Scores"
+// @Success 200 {object} response.WriteResponse
+// @Failure 400 {object} response.WriteResponse "Bad Request"
+// @Failure 401 {object} response.WriteResponse "Unauthorized"
+// @Failure 404 {object} response.WriteResponse "Not Found"
+// @Failure 500 {object} response.WriteResponse "Internal Server Error"
+// @Router /application/{applicationId}/scorecard [put]
+func (c *controllerImpl) UpdateApplicationScorecard(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.UpdateApplicationScorecardRequest{}
+	response := response.WriteResponse{}
+	response.Time = requestTime
+
+	applicationIdStr := chi.URLParam(r, "applicationId")
+	applicationId, err := strconv.Atoi(applicationIdStr)
+	if err != nil {
+		response.Message = ""
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Message = err.Error()
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.ApplicationId = applicationId
+	req.ReviewerId = int(claims["sub"].(float64))
+	err = c.jobUsecase.UpdateApplicationScorecard(ctx, req)
+	if err != nil {
+		if err == job.ErrInvalidScore {
+			response.Message = invalidScoreErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == sql.ErrNoRows {
+			response.Message = notFoundErrorMsg
+			setResponse(w, http.StatusNotFound, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Get the scorecard of a job
+// @Description Returns the criteria applications to one of the employer's jobs are scored on
+// @Tags Job
+// @Produce json
+// @Param jobId path int true "Job ID"
+// @Success 200 {object} response.ReadResponse{data=[]model.ScorecardCriterion}
+// @Failure 400 {object} response.ReadResponse "Bad Request"
+// @Failure 401 {object} response.ReadResponse "Unauthorized"
+// @Failure 404 {object} response.ReadResponse "Not Found"
+// @Failure 500 {object} response.ReadResponse "Internal Server Error"
+// @Router /job/{jobId}/scorecard [get]
+func (c *controllerImpl) GetScorecard(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.SearchScorecardRequest{}
+	response := response.ReadResponse{}
+	response.Time = requestTime
+
+	jobIdStr := chi.URLParam(r, "jobId")
+	jobId, err := strconv.Atoi(jobIdStr)
+	if err != nil {
+		response.Message = ""
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.JobId = jobId
+	req.EmployerId = int(claims["sub"].(float64))
+	criteria, err := c.jobUsecase.GetScorecard(ctx, req)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			response.Message = notFoundErrorMsg
+			setResponse(w, http.StatusNotFound, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	response.Data = criteria
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Update the scorecard of a job
+// @Description Replaces the weighted criteria applications to one of the employer's jobs are scored on; scores on removed criteria are deleted
+// @Tags Job
+// @Accept json
+// @Produce json
+// @Param jobId path int true "Job ID"
+// @Param request body request.UpdateScorecardRequest true "Scorecard"
+// @Success 200 {object} response.WriteResponse
+// @Failure 400 {object} response.WriteResponse "Bad Request"
+// @Failure 401 {object} response.WriteResponse "Unauthorized"
+// @Failure 404 {object} response.WriteResponse "Not Found"
+// @Failure 500 {object} response.WriteResponse "Internal Server Error"
+// @Router /job/{jobId}/scorecard [put]
+func (c *controllerImpl) UpdateScorecard(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.UpdateScorecardRequest{}
+	response := response.WriteResponse{}
+	response.Time = requestTime
+
+	jobIdStr := chi.URLParam(r, "jobId")
+	jobId, err := strconv.Atoi(jobIdStr)
+	if err != nil {
+		response.Message = ""
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Message = err.Error()
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.JobId = jobId
+	req.EmployerId = int(claims["sub"].(float64))
+	err = c.jobUsecase.UpdateScorecard(ctx, req)
+	if err != nil {
+		if err == job.ErrInvalidScorecard {
+			response.Message = invalidScorecardErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == sql.ErrNoRows {
+			response.Message = notFoundErrorMsg
+			setResponse(w, http.StatusNotFound, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Publish interview slots
+// @Description Adds times the employer is available to interview talents who applied to one of their jobs; slots can't overlap any other slot of the employer
+// @Tags Interview
+// @Accept json
+// @Produce json
+// @Param jobId path int true "Job ID"
+// @Param request body request.InsertInterviewSlotsRequest true "Interview Slots"
+// @Success 200 {object} response.ReadResponse{data=[]model.InterviewSlot}
+// @Failure 400 {object} response.ReadResponse "Bad Request"
+// @Failure 401 {object} response.ReadResponse "Unauthorized"
+// @Failure 404 {object} response.ReadResponse "Not Found"
+// @Failure 409 {object} response.ReadResponse "Interview Slot Conflict"
+// @Failure 500 {object} response.ReadResponse "Internal Server Error"
+// @Router /job/{jobId}/interview-slots [post]
+func (c *controllerImpl) InsertInterviewSlots(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.InsertInterviewSlotsRequest{}
+	response := response.ReadResponse{}
+	response.Time = requestTime
+
+	jobIdStr := chi.URLParam(r, "jobId")
+	jobId, err := strconv.Atoi(jobIdStr)
+	if err != nil {
+		response.Message = ""
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Message = err.Error()
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.JobId = jobId
+	req.EmployerId = int(claims["sub"].(float64))
+	slots, err := c.jobUsecase.InsertInterviewSlots(ctx, req)
+	if err != nil {
+		if err == job.ErrInvalidInterviewSlot {
+			response.Message = invalidInterviewSlotErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == job.ErrInterviewSlotConflict {
+			response.Message = interviewSlotConflictErrorMsg
+			setResponse(w, http.StatusConflict, response)
+			return
+		}
+		if err == sql.ErrNoRows {
+			response.Message = notFoundErrorMsg
+			setResponse(w, http.StatusNotFound, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	response.Data = slots
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Get the interview slots of a job
+// @Description Returns every interview slot of one of the employer's jobs with the application booked on it
+// @Tags Interview
+// @Produce json
+// @Param jobId path int true "Job ID"
+// @Success 200 {object} response.ReadResponse{data=[]model.InterviewSlot}
+// @Failure 400 {object} response.ReadResponse "Bad Request"
+// @Failure 401 {object} response.ReadResponse "Unauthorized"
+// @Failure 404 {object} response.ReadResponse "Not Found"
+// @Failure 500 {object} response.ReadResponse "Internal Server Error"
+// @Router /job/{jobId}/interview-slots [get]
+func (c *controllerImpl) GetInterviewSlots(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.SearchInterviewSlotsRequest{}
+	response := response.ReadResponse{}
+	response.Time = requestTime
+
+	jobIdStr := chi.URLParam(r, "jobId")
+	jobId, err := strconv.Atoi(jobIdStr)
+	if err != nil {
+		response.Message = ""
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.JobId = jobId
+	req.EmployerId = int(claims["sub"].(float64))
+	slots, err := c.jobUsecase.GetInterviewSlots(ctx, req)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			response.Message = notFoundErrorMsg
+			setResponse(w, http.StatusNotFound, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	response.Data = slots
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Delete an interview slot
+// @Description Deletes one of the employer's interview slots and cancels the interview booked on it
+// @Tags Interview
+// @Produce json
+// @Param slotId path int true "Interview Slot ID"
+// @Success 200 {object} response.WriteResponse
+// @Failure 400 {object} response.WriteResponse "Bad Request"
+// @Failure 401 {object} response.WriteResponse "Unauthorized"
+// @Failure 404 {object} response.WriteResponse "Not Found"
+// @Failure 500 {object} response.WriteResponse "Internal Server Error"
+// @Router /interview-slot/{slotId} [delete]
+func (c *controllerImpl) DeleteInterviewSlot(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.DeleteInterviewSlotRequest{}
+	response := response.WriteResponse{}
+	response.Time = requestTime
+
+	slotIdStr := chi.URLParam(r, "slotId")
+	slotId, err := strconv.Atoi(slotIdStr)
+	if err != nil {
+		response.Message = ""
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.SlotId = slotId
+	req.EmployerId = int(claims["sub"].(float64))
+	err = c.jobUsecase.DeleteInterviewSlot(ctx, req)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			response.Message = notFoundErrorMsg
+			setResponse(w, http.StatusNotFound, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Get the interview slots available for an application
+// @Description Returns the free, upcoming interview slots the talent can book for an application in the interview stage
+// @Tags Interview
+// @Produce json
+// @Param applicationId path int true "Application ID"
+// @Success 200 {object} response.ReadResponse{data=[]model.InterviewSlot}
+// @Failure 400 {object} response.ReadResponse "Bad Request"
+// @Failure 401 {object} response.ReadResponse "Unauthorized"
+// @Failure 404 {object} response.ReadResponse "Not Found"
+// @Failure 409 {object} response.ReadResponse "Application Not In Interview"
+// @Failure 500 {object} response.ReadResponse "Internal Server Error"
+// @Router /application/{applicationId}/interview-slots [get]
+func (c *controllerImpl) GetAvailableInterviewSlots(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.SearchAvailableInterviewSlotsRequest{}
+	response := response.ReadResponse{}
+	response.Time = requestTime
+
+	applicationIdStr := chi.URLParam(r, "applicationId")
+	applicationId, err := strconv.Atoi(applicationIdStr)
+	if err != nil {
+		response.Message = ""
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.ApplicationId = applicationId
+	req.TalentId = int(claims["sub"].(float64))
+	slots, err := c.jobUsecase.GetAvailableInterviewSlots(ctx, req)
+	if err != nil {
+		if err == job.ErrNotInInterview {
+			response.Message = notInInterviewErrorMsg
+			setResponse(w, http.StatusConflict, response)
+			return
+		}
+		if err == sql.ErrNoRows {
+			response.Message = notFoundErrorMsg
+			setResponse(w, http.StatusNotFound, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	response.Data = slots
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Book or reschedule an interview
+// @Description Books an interview slot for an application in the interview stage, moving the interview when one is already booked; both sides are sent an iCalendar invite
+// @Tags Interview
+// @Accept json
+// @Produce json
+// @Param applicationId path int true "Application ID"
+// @Param request body request.BookInterviewRequest true "Interview Slot"
+// @Success 200 {object} response.ReadResponse{data=model.Interview}
+// @Failure 400 {object} response.ReadResponse "Bad Request"
+// @Failure 401 {object} response.ReadResponse "Unauthorized"
+// @Failure 404 {object} response.ReadResponse "Not Found"
+// @Failure 409 {object} response.ReadResponse "Interview Slot Unavailable"
+// @Failure 500 {object} response.ReadResponse "Internal Server Error"
+// @Router /application/{applicationId}/interview [put]
+func (c *controllerImpl) BookInterview(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.BookInterviewRequest{}
+	response := response.ReadResponse{}
+	response.Time = requestTime
+
+	applicationIdStr := chi.URLParam(r, "applicationId")
+	applicationId, err := strconv.Atoi(applicationIdStr)
+	if err != nil {
+		response.Message = ""
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Message = err.Error()
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.ApplicationId = applicationId
+	req.TalentId = int(claims["sub"].(float64))
+	interview, err := c.jobUsecase.BookInterview(ctx, req)
+	if err != nil {
+		if err == job.ErrNotInInterview {
+			response.Message = notInInterviewErrorMsg
+			setResponse(w, http.StatusConflict, response)
+			return
+		}
+		if err == job.ErrInterviewSlotUnavailable {
+			response.Message = interviewSlotUnavailableErrorMsg
+			setResponse(w, http.StatusConflict, response)
+			return
+		}
+		if err == sql.ErrNoRows {
+			response.Message = notFoundErrorMsg
+			setResponse(w, http.StatusNotFound, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	response.Data = interview
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Cancel an interview
+// @Description Cancels the interview booked for an application and frees its slot; both sides are sent an iCalendar cancellation
+// @Tags Interview
+// @Produce json
+// @Param applicationId path int true "Application ID"
+// @Success 200 {object} response.WriteResponse
+// @Failure 400 {object} response.WriteResponse "Bad Request"
+// @Failure 401 {object} response.WriteResponse "Unauthorized"
+// @Failure 404 {object} response.WriteResponse "Not Found"
+// @Failure 500 {object} response.WriteResponse "Internal Server Error"
+// @Router /application/{applicationId}/interview [delete]
+func (c *controllerImpl) CancelInterview(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.CancelInterviewRequest{}
+	response := response.WriteResponse{}
+	response.Time = requestTime
+
+	applicationIdStr := chi.URLParam(r, "applicationId")
+	applicationId, err := strconv.Atoi(applicationIdStr)
+	if err != nil {
+		response.Message = ""
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.ApplicationId = applicationId
+	req.UserId = int(claims["sub"].(float64))
+	req.Role = int(claims["rle"].(float64))
+	err = c.jobUsecase.CancelInterview(ctx, req)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			response.Message = notFoundErrorMsg
+			setResponse(w, http.StatusNotFound, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Download the interview invite
+// @Description Returns the interview of an application as an RFC 5545 iCalendar file, or its cancellation once it is cancelled
+// @Tags Interview
+// @Produce plain
+// @Param applicationId path int true "Application ID"
+// @Success 200 {string} string
+// @Failure 400 {object} response.ReadResponse "Bad Request"
+// @Failure 401 {object} response.ReadResponse "Unauthorized"
+// @Failure 404 {object} response.ReadResponse "Not Found"
+// @Failure 500 {object} response.ReadResponse "Internal Server Error"
+// @Router /application/{applicationId}/interview.ics [get]
+func (c *controllerImpl) GetInterviewInvite(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.SearchInterviewRequest{}
+	response := response.ReadResponse{}
+	response.Time = requestTime
+
+	applicationIdStr := chi.URLParam(r, "applicationId")
+	applicationId, err := strconv.Atoi(applicationIdStr)
+	if err != nil {
+		response.Message = ""
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.ApplicationId = applicationId
+	req.UserId = int(claims["sub"].(float64))
+	req.Role = int(claims["rle"].(float64))
+	invite, err := c.jobUsecase.GetInterviewInvite(ctx, req)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			response.Message = notFoundErrorMsg
+			setResponse(w, http.StatusNotFound, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	w.Header().Set("Content-Type", ical.ContentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": interviewInviteFileName}))
+	w.Header().Set("Cache-Control", attachmentCacheControl)
+	w.WriteHeader(http.StatusOK)
+	w.Write(invite)
+}
+
+// @Summary Create or update the employer company profile
+// @Description Creates the company profile owned by the employer or updates it when it already exists
+// @Tags Company
+// @Accept json
+// @Produce json
+// @Param request body request.UpsertCompanyRequest true "Company Profile"
+// @Success 200 {object} response.WriteResponse
+// @Failure 400 {object} response.WriteResponse "Bad Request"
+// @Failure 401 {object} response.WriteResponse "Unauthorized"
+// @Failure 500 {object} response.WriteResponse "Internal Server Error"
+// @Router /employer/company [put]
+func (c *controllerImpl) UpsertCompany(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.UpsertCompanyRequest{}
+	response := response.WriteResponse{}
+	response.Time = requestTime
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Message = err.Error()
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.EmployerId = int(claims["sub"].(float64))
+	err = c.companyUsecase.UpsertCompany(ctx, req)
+	if err != nil {
+		if err == company.ErrInvalidCompany {
+			response.Message = invalidCompanyErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Get the employer company profile
+// @Description Retrieves the company profile owned by the employer
+// @Tags Company
+// @Produce json
+// @Success 200 {object} response.ReadResponse{data=model.Company}
+// @Failure 401 {object} response.ReadResponse "Unauthorized"
+// @Failure 404 {object} response.ReadResponse "Not Found"
+// @Failure 500 {object} response.ReadResponse "Internal Server Error"
+// @Router /employer/company [get]
+func (c *controllerImpl) GetCompanyByEmployerId(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.SearchCompanyByEmployerRequest{}
+	response := response.ReadResponse{}
+	response.Time = requestTime
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.EmployerId = int(claims["sub"].(float64))
+	company, err := c.companyUsecase.GetCompanyByEmployerId(ctx, req)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			response.Message = notFoundErrorMsg
+			setResponse(w, http.StatusNotFound, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	response.Data = company
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Get a company page
+// @Description Retrieves a company profile together with its open jobs
+// @Tags Company
+// @Produce json
+// @Param companyId path int true "Company ID"
+// @Success 200 {object} response.ReadResponse{data=model.CompanyPage}
+// @Failure 400 {object} response.ReadResponse "Bad Request"
+// @Failure 404 {object} response.ReadResponse "Not Found"
+// @Failure 500 {object} response.ReadResponse "Internal Server Error"
+// @Router /company/{companyId} [get]
+func (c *controllerImpl) GetCompanyPage(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.SearchCompanyByIdRequest{}
+	response := response.ReadResponse{}
+	response.Time = requestTime
+
+	companyIdStr := chi.URLParam(r, "companyId")
+	companyId, err := strconv.Atoi(companyIdStr)
+	if err != nil {
+		response.Message = ""
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	req.CompanyId = companyId
+	page, err := c.companyUsecase.GetCompanyPage(ctx, req)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			response.Message = notFoundErrorMsg
+			setResponse(w, http.StatusNotFound, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	response.Data = page
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Create or update the talent profile
+// @Description Creates or replaces the talent's profile with their headline, summary, work history, education, links and visibility
+// @Tags Profile
+// @Produce json
+// @Param request body request.UpsertTalentProfileRequest true "Upsert Talent Profile Request"
+// @Success 200 {object} response.WriteResponse
+// @Failure 400 {object} response.WriteResponse "Bad Request"
+// @Failure 401 {object} response.WriteResponse "Unauthorized"
+// @Failure 500 {object} response.WriteResponse "Internal Server Error"
+// @Router /me/profile [put]
+func (c *controllerImpl) UpsertProfile(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.UpsertTalentProfileRequest{}
+	response := response.WriteResponse{}
+	response.Time = requestTime
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Message = err.Error()
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.TalentId = int(claims["sub"].(float64))
+	err = c.profileUsecase.UpsertProfile(ctx, req)
+	if err != nil {
+		if err == profile.ErrInvalidProfile {
+			response.Message = invalidProfileErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Get the talent profile
+// @Description Retrieves the talent's own profile
+// @Tags Profile
+// @Produce json
+// @Success 200 {object} response.ReadResponse{data=model.TalentProfile}
+// @Failure 401 {object} response.ReadResponse "Unauthorized"
+// @Failure 404 {object} response.ReadResponse "Not Found"
+// @Failure 500 {object} response.ReadResponse "Internal Server Error"
+// @Router /me/profile [get]
+func (c *controllerImpl) GetProfile(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.SearchTalentProfileRequest{}
+	response := response.ReadResponse{}
+	response.Time = requestTime
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.TalentId = int(claims["sub"].(float64))
+	profile, err := c.profileUsecase.GetProfile(ctx, req)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			response.Message = notFoundErrorMsg
+			setResponse(w, http.StatusNotFound, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	response.Data = profile
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Delete the talent profile
+// @Description Deletes the talent's profile. Snapshots already sent with applications are kept
+// @Tags Profile
+// @Produce json
+// @Success 200 {object} response.WriteResponse
+// @Failure 401 {object} response.WriteResponse "Unauthorized"
+// @Failure 404 {object} response.WriteResponse "Not Found"
+// @Failure 500 {object} response.WriteResponse "Internal Server Error"
+// @Router /me/profile [delete]
+func (c *controllerImpl) DeleteProfile(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.DeleteTalentProfileRequest{}
+	response := response.WriteResponse{}
+	response.Time = requestTime
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.TalentId = int(claims["sub"].(float64))
+	err = c.profileUsecase.DeleteProfile(ctx, req)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			response.Message = notFoundErrorMsg
+			setResponse(w, http.StatusNotFound, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Get a talent profile
+// @Description Retrieves a talent's profile if it is public or the talent applied to one of the employer's jobs
+// @Tags Profile
+// @Produce json
+// @Param talentId path int true "Talent ID"
+// @Success 200 {object} response.ReadResponse{data=model.TalentProfile}
+// @Failure 400 {object} response.ReadResponse "Bad Request"
+// @Failure 401 {object} response.ReadResponse "Unauthorized"
+// @Failure 404 {object} response.ReadResponse "Not Found"
+// @Failure 500 {object} response.ReadResponse "Internal Server Error"
+// @Router /talent/{talentId}/profile [get]
+func (c *controllerImpl) GetTalentProfile(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.SearchTalentProfileRequest{}
+	response := response.ReadResponse{}
+	response.Time = requestTime
+
+	talentIdStr := chi.URLParam(r, "talentId")
+	talentId, err := strconv.Atoi(talentIdStr)
+	if err != nil {
+		response.Message = ""
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.TalentId = talentId
+	req.EmployerId = int(claims["sub"].(float64))
+	profile, err := c.profileUsecase.GetTalentProfile(ctx, req)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			response.Message = notFoundErrorMsg
+			setResponse(w, http.StatusNotFound, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	response.Data = profile
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Get the public job feed
+// @Description Renders open jobs as RSS 2.0, Atom, schema.org JobPosting JSON-LD or aggregator XML
+// @Tags Feed
+// @Produce xml,json
+// @Param format path string true "Feed format (rss, atom, jsonld or xml)"
+// @Param If-None-Match header string false "ETag of a cached copy"
+// @Success 200 {string} string
+// @Success 304 {string} string "Not Modified"
+// @Failure 404 {object} response.ReadResponse "Not Found"
+// @Failure 500 {object} response.ReadResponse "Internal Server Error"
+// @Router /feeds/jobs.{format} [get]
+func (c *controllerImpl) GetJobFeed(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.SearchJobFeedRequest{}
+	response := response.ReadResponse{}
+	response.Time = requestTime
+
+	req.Format = chi.URLParam(r, "format")
+	jobFeed, err := c.feedUsecase.GetJobFeed(ctx, req)
+	if err != nil {
+		if err == feed.ErrInvalidFeedFormat {
+			response.Message = notFoundErrorMsg
+			setResponse(w, http.StatusNotFound, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	w.Header().Set("ETag", jobFeed.ETag)
+	w.Header().Set("Cache-Control", feedCacheControl)
+	if etagMatches(r.Header.Get("If-None-Match"), jobFeed.ETag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", jobFeed.ContentType)
+	w.WriteHeader(http.StatusOK)
+	w.Write(jobFeed.Body)
+}
+
+// @Summary Save a job
+// @Description Adds a job to the talent's saved jobs
+// @Tags Saved Job
+// @Produce json
+// @Param jobId path int true "Job ID"
+// @Success 200 {object} response.WriteResponse
+// @Failure 400 {object} response.WriteResponse "Bad Request"
+// @Failure 401 {object} response.WriteResponse "Unauthorized"
+// @Failure 404 {object} response.WriteResponse "Not Found"
+// @Failure 500 {object} response.WriteResponse "Internal Server Error"
+// @Router /job/{jobId}/save [post]
+func (c *controllerImpl) SaveJob(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.SaveJobRequest{}
+	response := response.WriteResponse{}
+	response.Time = requestTime
+
+	jobIdStr := chi.URLParam(r, "jobId")
+	jobId, err := strconv.Atoi(jobIdStr)
+	if err != nil {
+		response.Message = ""
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.JobId = jobId
+	req.TalentId = int(claims["sub"].(float64))
+	err = c.jobUsecase.SaveJob(ctx, req)
+	if err != nil {
+		if err == sql.ErrNoRows || strings.Contains(err.Error(), "foreign key constraint") {
+			response.Message = notFoundErrorMsg
+			setResponse(w, http.StatusNotFound, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Unsave a job
+// @Description Removes a job from the talent's saved jobs
+// @Tags Saved Job
+// @Produce json
+// @Param jobId path int true "Job ID"
+// @Success 200 {object} response.WriteResponse
+// @Failure 400 {object} response.WriteResponse "Bad Request"
+// @Failure 401 {object} response.WriteResponse "Unauthorized"
+// @Failure 404 {object} response.WriteResponse "Not Found"
+// @Failure 500 {object} response.WriteResponse "Internal Server Error"
+// @Router /job/{jobId}/save [delete]
+func (c *controllerImpl) UnsaveJob(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.SaveJobRequest{}
+	response := response.WriteResponse{}
+	response.Time = requestTime
+
+	jobIdStr := chi.URLParam(r, "jobId")
+	jobId, err := strconv.Atoi(jobIdStr)
+	if err != nil {
+		response.Message = ""
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.JobId = jobId
+	req.TalentId = int(claims["sub"].(float64))
+	err = c.jobUsecase.UnsaveJob(ctx, req)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			response.Message = notFoundErrorMsg
+			setResponse(w, http.StatusNotFound, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Get saved jobs
+// @Description Retrieves the talent's saved jobs, flagging the ones that are closed or expired
+// @Tags Saved Job
+// @Produce json
+// @Param page query int false "Page number"
+// @Param limit query int false "Page size"
+// @Success 200 {object} response.ReadResponse{data=response.PaginatedResponse{items=[]model.SavedJob}}
+// @Failure 400 {object} response.ReadResponse "Bad Request"
+// @Failure 401 {object} response.ReadResponse "Unauthorized"
+// @Failure 500 {object} response.ReadResponse "Internal Server Error"
+// @Router /me/saved-jobs [get]
+func (c *controllerImpl) GetSavedJobs(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.SearchSavedJobRequest{}
+	response := response.ReadResponse{}
+	response.Time = requestTime
+
+	page, limit, err := parsePagination(r)
+	if err != nil {
+		response.Message = err.Error()
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.TalentId = int(claims["sub"].(float64))
+	req.Page = page
+	req.Limit = limit
+	savedJobs, total, err := c.jobUsecase.GetSavedJobs(ctx, req)
+	if err != nil {
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	response.Data = paginatedResponse(savedJobs, page, limit, total)
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Save a job search
+// @Description Saves a search definition that alerts the talent whenever a new matching job is posted
+// @Tags Saved Search
+// @Accept json
+// @Produce json
+// @Param request body request.InsertSavedSearchRequest true "Saved Search"
+// @Success 200 {object} response.WriteResponse
+// @Failure 400 {object} response.WriteResponse "Bad Request"
+// @Failure 401 {object} response.WriteResponse "Unauthorized"
+// @Failure 500 {object} response.WriteResponse "Internal Server Error"
+// @Router /me/saved-searches [post]
+func (c *controllerImpl) InsertSavedSearch(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.InsertSavedSearchRequest{}
+	response := response.WriteResponse{}
+	response.Time = requestTime
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Message = err.Error()
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.TalentId = int(claims["sub"].(float64))
+	err = c.jobUsecase.InsertSavedSearch(ctx, req)
+	if err != nil {
+		if err == job.ErrInvalidSavedSearch || strings.Contains(err.Error(), "foreign key constraint") {
+			response.Message = invalidSavedSearchErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == job.ErrInvalidSkill {
+			response.Message = invalidSkillErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Get saved job searches
+// @Description Retrieves the talent's saved searches
+// @Tags Saved Search
+// @Produce json
+// @Success 200 {object} response.ReadResponse{data=[]model.SavedSearch}
+// @Failure 401 {object} response.ReadResponse "Unauthorized"
+// @Failure 500 {object} response.ReadResponse "Internal Server Error"
+// @Router /me/saved-searches [get]
+func (c *controllerImpl) GetSavedSearches(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.SearchSavedSearchRequest{}
+	response := response.ReadResponse{}
+	response.Time = requestTime
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.TalentId = int(claims["sub"].(float64))
+	savedSearches, err := c.jobUsecase.GetSavedSearches(ctx, req)
+	if err != nil {
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	response.Data = savedSearches
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Delete a saved job search
+// @Description Deletes one of the talent's saved searches and stops its alerts
+// @Tags Saved Search
+// @Produce json
+// @Param savedSearchId path int true "Saved Search ID"
+// @Success 200 {object} response.WriteResponse
+// @Failure 400 {object} response.WriteResponse "Bad Request"
+// @Failure 401 {object} response.WriteResponse "Unauthorized"
+// @Failure 404 {object} response.WriteResponse "Not Found"
+// @Failure 500 {object} response.WriteResponse "Internal Server Error"
+// @Router /me/saved-searches/{savedSearchId} [delete]
+func (c *controllerImpl) DeleteSavedSearch(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.DeleteSavedSearchRequest{}
+	response := response.WriteResponse{}
+	response.Time = requestTime
+
+	savedSearchIdStr := chi.URLParam(r, "savedSearchId")
+	savedSearchId, err := strconv.Atoi(savedSearchIdStr)
+	if err != nil {
+		response.Message = ""
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.SavedSearchId = savedSearchId
+	req.TalentId = int(claims["sub"].(float64))
+	err = c.jobUsecase.DeleteSavedSearch(ctx, req)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			response.Message = notFoundErrorMsg
+			setResponse(w, http.StatusNotFound, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Get talent skills
+// @Description Retrieves the skills the talent declared
+// @Tags Skill
+// @Produce json
+// @Success 200 {object} response.ReadResponse{data=[]string}
+// @Failure 401 {object} response.ReadResponse "Unauthorized"
+// @Failure 500 {object} response.ReadResponse "Internal Server Error"
+// @Router /me/skills [get]
+func (c *controllerImpl) GetTalentSkills(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.SearchTalentSkillsRequest{}
+	response := response.ReadResponse{}
+	response.Time = requestTime
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.TalentId = int(claims["sub"].(float64))
+	skills, err := c.jobUsecase.GetTalentSkills(ctx, req)
+	if err != nil {
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	response.Data = skills
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Update talent skills
+// @Description Replaces the skills the talent declared
+// @Tags Skill
+// @Accept json
+// @Produce json
+// @Param request body request.UpdateTalentSkillsRequest true "Talent Skills"
+// @Success 200 {object} response.WriteResponse
+// @Failure 400 {object} response.WriteResponse "Bad Request"
+// @Failure 401 {object} response.WriteResponse "Unauthorized"
+// @Failure 500 {object} response.WriteResponse "Internal Server Error"
+// @Router /me/skills [put]
+func (c *controllerImpl) UpdateTalentSkills(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.UpdateTalentSkillsRequest{}
+	response := response.WriteResponse{}
+	response.Time = requestTime
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Message = err.Error()
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.TalentId = int(claims["sub"].(float64))
+	err = c.jobUsecase.UpdateTalentSkills(ctx, req)
+	if err != nil {
+		if err == job.ErrInvalidSkill {
+			response.Message = invalidSkillErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Get recommended jobs
+// @Description Ranks open jobs against the talent's skills, applications and saved jobs, with the reasons each job matched
+// @Tags Job
+// @Produce json
+// @Param limit query int false "Maximum number of jobs"
+// @Success 200 {object} response.ReadResponse{data=[]model.RecommendedJob}
+// @Failure 400 {object} response.ReadResponse "Bad Request"
+// @Failure 401 {object} response.ReadResponse "Unauthorized"
+// @Failure 500 {object} response.ReadResponse "Internal Server Error"
+// @Router /me/recommended-jobs [get]
+func (c *controllerImpl) GetRecommendedJobs(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.SearchRecommendedJobRequest{}
+	response := response.ReadResponse{}
+	response.Time = requestTime
+
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil {
+			response.Message = invalidPaginationErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		req.Limit = limit
+	}
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.TalentId = int(claims["sub"].(float64))
+	jobs, err := c.jobUsecase.GetRecommendedJobs(ctx, req)
+	if err != nil {
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	response.Data = jobs
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Get the moderation queue
+// @Description Retrieves the jobs waiting for an admin decision with the flags raised against them, oldest first
+// @Tags Moderation
+// @Produce json
+// @Param page query int false "Page number"
+// @Param limit query int false "Page size"
+// @Success 200 {object} response.ReadResponse{data=response.PaginatedResponse}
+// @Failure 400 {object} response.ReadResponse "Bad Request"
+// @Failure 401 {object} response.ReadResponse "Unauthorized"
+// @Failure 500 {object} response.ReadResponse "Internal Server Error"
+// @Router /admin/moderation/jobs [get]
+func (c *controllerImpl) GetModerationJobs(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.SearchModerationJobRequest{}
+	response := response.ReadResponse{}
+	response.Time = requestTime
+
+	page, limit, err := parsePagination(r)
+	if err != nil {
+		response.Message = err.Error()
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	req.Page = page
+	req.Limit = limit
+	moderationJobs, total, err := c.jobUsecase.GetModerationJobs(ctx, req)
+	if err != nil {
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	response.Data = paginatedResponse(moderationJobs, page, limit, total)
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Approve a job
+// @Description Publishes a job waiting for moderation and notifies the employer
+// @Tags Moderation
+// @Accept json
+// @Produce json
+// @Param jobId path int true "Job ID"
+// @Param request body request.ModerateJobRequest false "Optional reason"
+// @Success 200 {object} response.WriteResponse
+// @Failure 400 {object} response.WriteResponse "Bad Request"
+// @Failure 401 {object} response.WriteResponse "Unauthorized"
+// @Failure 404 {object} response.WriteResponse "Not Found"
+// @Failure 500 {object} response.WriteResponse "Internal Server Error"
+// @Router /admin/moderation/job/{jobId}/approve [post]
+func (c *controllerImpl) ApproveJob(w http.ResponseWriter, r *http.Request) {
+	c.moderateJob(w, r, enum.ApprovedModerationStatus)
+}
+
+// @Summary Reject a job
+// @Description Rejects a job waiting for moderation and notifies the employer with the reason
+// @Tags Moderation
+// @Accept json
+// @Produce json
+// @Param jobId path int true "Job ID"
+// @Param request body request.ModerateJobRequest true "Reason"
+// @Success 200 {object} response.WriteResponse
+// @Failure 400 {object} response.WriteResponse "Bad Request"
+// @Failure 401 {object} response.WriteResponse "Unauthorized"
+// @Failure 404 {object} response.WriteResponse "Not Found"
+// @Failure 500 {object} response.WriteResponse "Internal Server Error"
+// @Router /admin/moderation/job/{jobId}/reject [post]
+func (c *controllerImpl) RejectJob(w http.ResponseWriter, r *http.Request) {
+	c.moderateJob(w, r, enum.RejectedModerationStatus)
+}
+
+func (c *controllerImpl) moderateJob(w http.ResponseWriter, r *http.Request, decision int) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.ModerateJobRequest{}
+	response := response.WriteResponse{}
+	response.Time = requestTime
+
+	jobIdStr := chi.URLParam(r, "jobId")
+	jobId, err := strconv.Atoi(jobIdStr)
+	if err != nil {
+		response.Message = ""
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	// The body is optional; approvals don't need a reason.
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && err != io.EOF {
+		response.Message = err.Error()
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.JobId = jobId
+	req.AdminId = int(claims["sub"].(float64))
+	req.Decision = decision
+	err = c.jobUsecase.ModerateJob(ctx, req)
+	if err != nil {
+		if err == job.ErrInvalidReason {
+			response.Message = invalidReasonErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == sql.ErrNoRows {
+			response.Message = notFoundErrorMsg
+			setResponse(w, http.StatusNotFound, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Verify an employer
+// @Description Marks an employer as verified, so their new and edited postings go live without moderation
+// @Tags Moderation
+// @Produce json
+// @Param employerId path int true "Employer ID"
+// @Success 200 {object} response.WriteResponse
+// @Failure 400 {object} response.WriteResponse "Bad Request"
+// @Failure 401 {object} response.WriteResponse "Unauthorized"
+// @Failure 404 {object} response.WriteResponse "Not Found"
+// @Failure 500 {object} response.WriteResponse "Internal Server Error"
+// @Router /admin/employer/{employerId}/verify [put]
+func (c *controllerImpl) VerifyEmployer(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.VerifyEmployerRequest{}
+	response := response.WriteResponse{}
+	response.Time = requestTime
+
+	employerIdStr := chi.URLParam(r, "employerId")
+	employerId, err := strconv.Atoi(employerIdStr)
+	if err != nil {
+		response.Message = ""
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	req.EmployerId = employerId
+	err = c.userUsecase.VerifyEmployer(ctx, req)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			response.Message = notFoundErrorMsg
+			setResponse(w, http.StatusNotFound, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Report a job
+// @Description Flags a job as abusive. Each talent can report a job once, and jobs reported by enough talents are hidden until an admin reviews them.
+// @Tags Report
+// @Accept json
+// @Produce json
+// @Param jobId path int true "Job ID"
+// @Param request body request.InsertJobReportRequest true "Report"
+// @Success 200 {object} response.WriteResponse
+// @Failure 400 {object} response.WriteResponse "Bad Request"
+// @Failure 401 {object} response.WriteResponse "Unauthorized"
+// @Failure 404 {object} response.WriteResponse "Not Found"
+// @Failure 409 {object} response.WriteResponse "Duplicate Report"
+// @Failure 500 {object} response.WriteResponse "Internal Server Error"
+// @Router /job/{jobId}/report [post]
+func (c *controllerImpl) ReportJob(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.InsertJobReportRequest{}
+	response := response.WriteResponse{}
+	response.Time = requestTime
+
+	jobIdStr := chi.URLParam(r, "jobId")
+	jobId, err := strconv.Atoi(jobIdStr)
+	if err != nil {
+		response.Message = ""
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Message = err.Error()
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.JobId = jobId
+	req.TalentId = int(claims["sub"].(float64))
+	err = c.jobUsecase.ReportJob(ctx, req)
+	if err != nil {
+		if err == job.ErrInvalidReport {
+			response.Message = invalidReportErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == job.ErrDuplicateReport {
+			response.Message = duplicateReportErrorMsg
+			setResponse(w, http.StatusConflict, response)
+			return
+		}
+		if err == sql.ErrNoRows {
+			response.Message = notFoundErrorMsg
+			setResponse(w, http.StatusNotFound, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Get job reports
+// @Description Retrieves job reports for triage, oldest first
+// @Tags Report
+// @Produce json
+// @Param status query int false "Report status: 1 open, 2 dismissed, 3 upheld"
+// @Param page query int false "Page number"
+// @Param limit query int false "Page size"
+// @Success 200 {object} response.ReadResponse{data=response.PaginatedResponse}
+// @Failure 400 {object} response.ReadResponse "Bad Request"
+// @Failure 401 {object} response.ReadResponse "Unauthorized"
+// @Failure 500 {object} response.ReadResponse "Internal Server Error"
+// @Router /admin/reports [get]
+func (c *controllerImpl) GetJobReports(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.SearchJobReportRequest{}
+	response := response.ReadResponse{}
+	response.Time = requestTime
+
+	page, limit, err := parsePagination(r)
+	if err != nil {
+		response.Message = err.Error()
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	if status := r.URL.Query().Get("status"); status != "" {
+		req.ReportStatus, err = strconv.Atoi(status)
+		if err != nil {
+			response.Message = invalidReportErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+	}
+
+	req.Page = page
+	req.Limit = limit
+	reports, total, err := c.jobUsecase.GetJobReports(ctx, req)
+	if err != nil {
+		if err == job.ErrInvalidReport {
+			response.Message = invalidReportErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	response.Data = paginatedResponse(reports, page, limit, total)
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Resolve a job report
+// @Description Dismisses or upholds an open report. Upholding a report rejects the job and sends the resolution to the employer.
+// @Tags Report
+// @Accept json
+// @Produce json
+// @Param reportId path int true "Report ID"
+// @Param request body request.ResolveJobReportRequest true "Resolution"
+// @Success 200 {object} response.WriteResponse
+// @Failure 400 {object} response.WriteResponse "Bad Request"
+// @Failure 401 {object} response.WriteResponse "Unauthorized"
+// @Failure 404 {object} response.WriteResponse "Not Found"
+// @Failure 500 {object} response.WriteResponse "Internal Server Error"
+// @Router /admin/report/{reportId} [put]
+func (c *controllerImpl) ResolveJobReport(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.ResolveJobReportRequest{}
+	response := response.WriteResponse{}
+	response.Time = requestTime
+
+	reportIdStr := chi.URLParam(r, "reportId")
+	reportId, err := strconv.Atoi(reportIdStr)
+	if err != nil {
+		response.Message = ""
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Message = err.Error()
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.ReportId = reportId
+	req.AdminId = int(claims["sub"].(float64))
+	err = c.jobUsecase.ResolveJobReport(ctx, req)
+	if err != nil {
+		if err == job.ErrInvalidReport {
+			response.Message = invalidReportErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == job.ErrInvalidReason {
+			response.Message = invalidReasonErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == sql.ErrNoRows {
+			response.Message = notFoundErrorMsg
+			setResponse(w, http.StatusNotFound, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Upload an attachment
+// @Description Uploads a resume or portfolio file for the talent to send with applications. Files are checked for size, type and malware before they are stored.
+// @Tags Attachment
+// @Accept mpfd
+// @Produce json
+// @Param file formData file true "File"
+// @Param kind formData int true "Attachment kind: 1 resume, 2 portfolio"
+// @Success 200 {object} response.ReadResponse{data=model.Attachment}
+// @Failure 400 {object} response.ReadResponse "Bad Request"
+// @Failure 401 {object} response.ReadResponse "Unauthorized"
+// @Failure 413 {object} response.ReadResponse "File Too Large"
+// @Failure 415 {object} response.ReadResponse "Unsupported File Type"
+// @Failure 422 {object} response.ReadResponse "Infected File"
+// @Failure 500 {object} response.ReadResponse "Internal Server Error"
+// @Router /me/attachments [post]
+func (c *controllerImpl) UploadAttachment(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.UploadAttachmentRequest{}
+	response := response.ReadResponse{}
+	response.Time = requestTime
+
+	r.Body = http.MaxBytesReader(w, r.Body, c.attachmentUsecase.MaxUploadSize()+maxMultipartOverhead)
+	if err := r.ParseMultipartForm(maxUploadMemory); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			response.Message = fileTooLargeErrorMsg
+			setResponse(w, http.StatusRequestEntityTooLarge, response)
+			return
+		}
+		response.Message = err.Error()
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		response.Message = err.Error()
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+	defer file.Close()
+
+	req.Kind, err = strconv.Atoi(r.FormValue("kind"))
+	if err != nil {
+		response.Message = invalidAttachmentErrorMsg
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	req.Data, err = io.ReadAll(file)
+	if err != nil {
+		response.Message = err.Error()
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.TalentId = int(claims["sub"].(float64))
+	req.FileName = header.Filename
+	uploaded, err := c.attachmentUsecase.UploadAttachment(ctx, req)
+	if err != nil {
+		if err == attachment.ErrInvalidAttachment {
+			response.Message = invalidAttachmentErrorMsg
+			setResponse(w, http.StatusBadRequest, response)
+			return
+		}
+		if err == attachment.ErrFileTooLarge {
+			response.Message = fileTooLargeErrorMsg
+			setResponse(w, http.StatusRequestEntityTooLarge, response)
+			return
+		}
+		if err == attachment.ErrUnsupportedFileType {
+			response.Message = unsupportedFileTypeErrorMsg
+			setResponse(w, http.StatusUnsupportedMediaType, response)
+			return
+		}
+		if err == attachment.ErrInfectedFile {
+			response.Message = infectedFileErrorMsg
+			setResponse(w, http.StatusUnprocessableEntity, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	response.Data = uploaded
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Get my attachments
+// @Description Retrieves the files the talent uploaded, newest first
+// @Tags Attachment
+// @Produce json
+// @Success 200 {object} response.ReadResponse{data=[]model.Attachment}
+// @Failure 401 {object} response.ReadResponse "Unauthorized"
+// @Failure 500 {object} response.ReadResponse "Internal Server Error"
+// @Router /me/attachments [get]
+func (c *controllerImpl) GetAttachments(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.SearchAttachmentRequest{}
+	response := response.ReadResponse{}
+	response.Time = requestTime
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.TalentId = int(claims["sub"].(float64))
+	attachments, err := c.attachmentUsecase.GetAttachments(ctx, req)
+	if err != nil {
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	response.Data = attachments
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Get an attachment download link
+// @Description Signs a short lived download link for the talent owning the attachment or an employer it was sent to
+// @Tags Attachment
+// @Produce json
+// @Param attachmentId path int true "Attachment ID"
+// @Success 200 {object} response.ReadResponse{data=model.AttachmentURL}
+// @Failure 400 {object} response.ReadResponse "Bad Request"
+// @Failure 401 {object} response.ReadResponse "Unauthorized"
+// @Failure 404 {object} response.ReadResponse "Not Found"
+// @Failure 500 {object} response.ReadResponse "Internal Server Error"
+// @Router /attachment/{attachmentId}/url [get]
+func (c *controllerImpl) GetAttachmentURL(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.SearchAttachmentURLRequest{}
+	response := response.ReadResponse{}
+	response.Time = requestTime
+
+	attachmentIdStr := chi.URLParam(r, "attachmentId")
+	attachmentId, err := strconv.Atoi(attachmentIdStr)
+	if err != nil {
+		response.Message = ""
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	_, claims, err := parseHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		response.Message = unableToParseTokenErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	req.AttachmentId = attachmentId
+	req.UserId = int(claims["sub"].(float64))
+	req.Role = int(claims["rle"].(float64))
+	attachmentURL, err := c.attachmentUsecase.GetAttachmentURL(ctx, req)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			response.Message = notFoundErrorMsg
+			setResponse(w, http.StatusNotFound, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	response.Message = ""
+	response.Data = attachmentURL
+	setResponse(w, http.StatusOK, response)
+}
+
+// @Summary Download an attachment
+// @Description Serves an attachment through a signed link from GET /attachment/{attachmentId}/url. No token is needed, the signature grants access until the link expires.
+// @Tags Attachment
+// @Produce octet-stream
+// @Param attachmentId path int true "Attachment ID"
+// @Param expires query int true "Expiry as a Unix timestamp"
+// @Param signature query string true "Link signature"
+// @Success 200 {file} file
+// @Failure 400 {object} response.ReadResponse "Bad Request"
+// @Failure 403 {object} response.ReadResponse "Invalid Signature"
+// @Failure 404 {object} response.ReadResponse "Not Found"
+// @Failure 410 {object} response.ReadResponse "Expired Link"
+// @Failure 500 {object} response.ReadResponse "Internal Server Error"
+// @Router /attachment/{attachmentId}/download [get]
+func (c *controllerImpl) DownloadAttachment(w http.ResponseWriter, r *http.Request) {
+	requestTime := time.Now().Format(time.RFC3339)
+	ctx := r.Context()
+	req := request.DownloadAttachmentRequest{}
+	response := response.ReadResponse{}
+	response.Time = requestTime
+
+	attachmentIdStr := chi.URLParam(r, "attachmentId")
+	attachmentId, err := strconv.Atoi(attachmentIdStr)
+	if err != nil {
+		response.Message = ""
+		setResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	query := r.URL.Query()
+	req.Expires, err = strconv.ParseInt(query.Get("expires"), 10, 64)
+	if err != nil {
+		response.Message = invalidSignatureErrorMsg
+		setResponse(w, http.StatusForbidden, response)
+		return
+	}
+
+	req.AttachmentId = attachmentId
+	req.Signature = query.Get("signature")
+	downloaded, content, err := c.attachmentUsecase.DownloadAttachment(ctx, req)
+	if err != nil {
+		if err == attachment.ErrInvalidSignature {
+			response.Message = invalidSignatureErrorMsg
+			setResponse(w, http.StatusForbidden, response)
+			return
+		}
+		if err == attachment.ErrExpiredLink {
+			response.Message = expiredLinkErrorMsg
+			setResponse(w, http.StatusGone, response)
+			return
+		}
+		if err == sql.ErrNoRows {
+			response.Message = notFoundErrorMsg
+			setResponse(w, http.StatusNotFound, response)
+			return
+		}
+		response.Message = internalServerErrorMsg
+		setResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+	defer content.Close()
+
+	w.Header().Set("Content-Type", downloaded.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(downloaded.Size, 10))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": downloaded.FileName}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Cache-Control", attachmentCacheControl)
+	w.WriteHeader(http.StatusOK)
+	io.Copy(w, content)
+}
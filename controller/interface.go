@@ -12,8 +12,67 @@ type Controller interface {
 	GetAllJob(w http.ResponseWriter, r *http.Request)
 	GetJobById(w http.ResponseWriter, r *http.Request)
 	InsertJob(w http.ResponseWriter, r *http.Request)
+	UpdateJob(w http.ResponseWriter, r *http.Request)
+	GetJobRevisions(w http.ResponseWriter, r *http.Request)
+	GetJobRevisionDiff(w http.ResponseWriter, r *http.Request)
+	ImportJobs(w http.ResponseWriter, r *http.Request)
+	GetJobImport(w http.ResponseWriter, r *http.Request)
+	UpdateJobStatus(w http.ResponseWriter, r *http.Request)
+	GetJobsByEmployerId(w http.ResponseWriter, r *http.Request)
+	SearchSkills(w http.ResponseWriter, r *http.Request)
 	GetApplicationsByJobId(w http.ResponseWriter, r *http.Request)
 	GetApplicationById(w http.ResponseWriter, r *http.Request)
+	GetMyApplications(w http.ResponseWriter, r *http.Request)
 	InsertApplication(w http.ResponseWriter, r *http.Request)
 	UpdateApplicationStatus(w http.ResponseWriter, r *http.Request)
+	WithdrawApplication(w http.ResponseWriter, r *http.Request)
+	InsertApplicationNote(w http.ResponseWriter, r *http.Request)
+	DeleteApplicationNote(w http.ResponseWriter, r *http.Request)
+	UpdateApplicationTags(w http.ResponseWriter, r *http.Request)
+	UpdateApplicationScorecard(w http.ResponseWriter, r *http.Request)
+	GetScorecard(w http.ResponseWriter, r *http.Request)
+	UpdateScorecard(w http.ResponseWriter, r *http.Request)
+	InsertInterviewSlots(w http.ResponseWriter, r *http.Request)
+	GetInterviewSlots(w http.ResponseWriter, r *http.Request)
+	DeleteInterviewSlot(w http.ResponseWriter, r *http.Request)
+	GetAvailableInterviewSlots(w http.ResponseWriter, r *http.Request)
+	BookInterview(w http.ResponseWriter, r *http.Request)
+	CancelInterview(w http.ResponseWriter, r *http.Request)
+	GetInterviewInvite(w http.ResponseWriter, r *http.Request)
+
+	UploadAttachment(w http.ResponseWriter, r *http.Request)
+	GetAttachments(w http.ResponseWriter, r *http.Request)
+	GetAttachmentURL(w http.ResponseWriter, r *http.Request)
+	DownloadAttachment(w http.ResponseWriter, r *http.Request)
+
+	UpsertCompany(w http.ResponseWriter, r *http.Request)
+	GetCompanyByEmployerId(w http.ResponseWriter, r *http.Request)
+	GetCompanyPage(w http.ResponseWriter, r *http.Request)
+	GetJobFeed(w http.ResponseWriter, r *http.Request)
+
+	UpsertProfile(w http.ResponseWriter, r *http.Request)
+	GetProfile(w http.ResponseWriter, r *http.Request)
+	DeleteProfile(w http.ResponseWriter, r *http.Request)
+	GetTalentProfile(w http.ResponseWriter, r *http.Request)
+
+	SaveJob(w http.ResponseWriter, r *http.Request)
+	UnsaveJob(w http.ResponseWriter, r *http.Request)
+	GetSavedJobs(w http.ResponseWriter, r *http.Request)
+
+	InsertSavedSearch(w http.ResponseWriter, r *http.Request)
+	GetSavedSearches(w http.ResponseWriter, r *http.Request)
+	DeleteSavedSearch(w http.ResponseWriter, r *http.Request)
+
+	GetTalentSkills(w http.ResponseWriter, r *http.Request)
+	UpdateTalentSkills(w http.ResponseWriter, r *http.Request)
+	GetRecommendedJobs(w http.ResponseWriter, r *http.Request)
+
+	GetModerationJobs(w http.ResponseWriter, r *http.Request)
+	ApproveJob(w http.ResponseWriter, r *http.Request)
+	RejectJob(w http.ResponseWriter, r *http.Request)
+	VerifyEmployer(w http.ResponseWriter, r *http.Request)
+
+	ReportJob(w http.ResponseWriter, r *http.Request)
+	GetJobReports(w http.ResponseWriter, r *http.Request)
+	ResolveJobReport(w http.ResponseWriter, r *http.Request)
 }
@@ -3,10 +3,17 @@ package config
 import "time"
 
 type ApplicationConfig struct {
-	Port     PortConfig     `json:"port"`
-	Database DatabaseConfig `json:"database"`
-	JWT      JWTConfig      `json:"jwt"`
-	Encrypt  EncryptConfig  `json:"encrypt"`
+	Port         PortConfig         `json:"port"`
+	Database     DatabaseConfig     `json:"database"`
+	JWT          JWTConfig          `json:"jwt"`
+	Encrypt      EncryptConfig      `json:"encrypt"`
+	Notifier     NotifierConfig     `json:"notifier"`
+	Alert        AlertConfig        `json:"alert"`
+	Feed         FeedConfig         `json:"feed"`
+	Moderation   ModerationConfig   `json:"moderation"`
+	Applications ApplicationsConfig `json:"applications"`
+	Storage      StorageConfig      `json:"storage"`
+	Scanner      ScannerConfig      `json:"scanner"`
 }
 
 type PortConfig struct {
@@ -33,3 +40,59 @@ type JWTConfig struct {
 type EncryptConfig struct {
 	SecretKey string `json:"secretkey"`
 }
+
+type NotifierConfig struct {
+	Type       string `json:"type"`
+	OutboxPath string `json:"outbox_path"`
+}
+
+type AlertConfig struct {
+	DigestInterval time.Duration `json:"digest_interval"`
+}
+
+type FeedConfig struct {
+	Title       string `json:"title"`
+	BaseURL     string `json:"base_url"`
+	Description string `json:"description"`
+	MaxItems    int    `json:"max_items"`
+}
+
+type ModerationConfig struct {
+	DuplicatePolicy     string   `json:"duplicate_policy"`
+	DuplicateThreshold  float64  `json:"duplicate_threshold"`
+	DuplicateWindowDays int      `json:"duplicate_window_days"`
+	BannedPhrases       []string `json:"banned_phrases"`
+	ReportThreshold     int      `json:"report_threshold"`
+}
+
+// ApplicationsConfig decides whether a talent who withdrew an application can
+// apply to the same job again: never, always, or once ReapplyCooldownDays
+// have passed since the withdrawal.
+type ApplicationsConfig struct {
+	ReapplyPolicy       string `json:"reapply_policy"`
+	ReapplyCooldownDays int    `json:"reapply_cooldown_days"`
+}
+
+// StorageConfig selects where uploaded files are kept. The endpoint, region,
+// bucket and keys are only read by the s3 type. Download links are built on
+// BaseURL, signed with SigningKey and stay valid for URLExpiry minutes.
+type StorageConfig struct {
+	Type          string        `json:"type"`
+	LocalPath     string        `json:"local_path"`
+	Endpoint      string        `json:"endpoint"`
+	Region        string        `json:"region"`
+	Bucket        string        `json:"bucket"`
+	AccessKey     string        `json:"access_key"`
+	SecretKey     string        `json:"secret_key"`
+	PathStyle     bool          `json:"path_style"`
+	BaseURL       string        `json:"base_url"`
+	SigningKey    string        `json:"signing_key"`
+	URLExpiry     time.Duration `json:"url_expiry"`
+	MaxUploadSize int64         `json:"max_upload_size"`
+}
+
+type ScannerConfig struct {
+	Type    string        `json:"type"`
+	Address string        `json:"address"`
+	Timeout time.Duration `json:"timeout"`
+}
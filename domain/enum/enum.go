@@ -3,6 +3,7 @@ package enum
 const (
 	TalentRole   = 1
 	EmployerRole = 2
+	AdminRole    = 3
 )
 
 const (
@@ -10,4 +11,122 @@ const (
 	InterviewStatus = 2
 	AcceptedStatus  = 3
 	DeclinedStatus  = 4
+	WithdrawnStatus = 5
+)
+
+const (
+	BookedInterviewStatus    = 1
+	CancelledInterviewStatus = 2
+)
+
+const (
+	PrivateProfileVisibility     = 1
+	ApplicationProfileVisibility = 2
+	PublicProfileVisibility      = 3
+)
+
+const (
+	CoverLetterField    = "cover_letter"
+	ExpectedSalaryField = "expected_salary"
+	AvailableFromField  = "available_from"
+	LinksField          = "links"
+)
+
+const (
+	ResumeAttachment    = 1
+	PortfolioAttachment = 2
+)
+
+const (
+	ReapplyPolicyNever    = "never"
+	ReapplyPolicyCooldown = "cooldown"
+	ReapplyPolicyAlways   = "always"
+)
+
+const (
+	OpenJobStatus   = 1
+	ClosedJobStatus = 2
+)
+
+const (
+	ApprovedModerationStatus = 1
+	PendingModerationStatus  = 2
+	RejectedModerationStatus = 3
+)
+
+const (
+	ScamReportCategory           = 1
+	SpamReportCategory           = 2
+	MisleadingReportCategory     = 3
+	OffensiveReportCategory      = 4
+	DiscriminatoryReportCategory = 5
+	OtherReportCategory          = 6
+)
+
+const (
+	OpenReportStatus      = 1
+	DismissedReportStatus = 2
+	UpheldReportStatus    = 3
+)
+
+const (
+	DuplicatePolicyOff    = "off"
+	DuplicatePolicyWarn   = "warn"
+	DuplicatePolicyReject = "reject"
+)
+
+const (
+	PendingImportStatus   = 1
+	RunningImportStatus   = 2
+	CompletedImportStatus = 3
+	FailedImportStatus    = 4
+)
+
+const (
+	ImportFormatCSV   = "csv"
+	ImportFormatJSONL = "jsonl"
+)
+
+const (
+	AtomicImportMode  = "atomic"
+	PartialImportMode = "partial"
+)
+
+const (
+	YesNoQuestion        = 1
+	SingleChoiceQuestion = 2
+	FreeTextQuestion     = 3
+	NumericQuestion      = 4
+)
+
+const (
+	InstantAlertFrequency = 1
+	DailyAlertFrequency   = 2
+)
+
+const (
+	SortByCreateDate   = "create_date"
+	SortByTitle        = "title"
+	SortByApplications = "applications"
+	SortByDistance     = "distance"
+)
+
+const (
+	SortOrderAsc  = "asc"
+	SortOrderDesc = "desc"
+)
+
+const (
+	DefaultPage  = 1
+	DefaultLimit = 10
+	MaxLimit     = 100
+)
+
+const (
+	CompanySizeMicro      = "1-10"
+	CompanySizeSmall      = "11-50"
+	CompanySizeMedium     = "51-200"
+	CompanySizeLarge      = "201-500"
+	CompanySizeVeryLarge  = "501-1000"
+	CompanySizeEnterprise = "1000+"
 )
@@ -3,9 +3,95 @@ package model
 import "time"
 
 type Application struct {
-	ID                int       `json:"id"`
-	JobId             int       `json:"job_id"`
-	TalentId          int       `json:"talent_id"`
-	ApplicationStatus int       `json:"application_status"`
-	ApplyDate         time.Time `json:"apply_date"`
+	ID                int                       `json:"id"`
+	JobId             int                       `json:"job_id"`
+	TalentId          int                       `json:"talent_id"`
+	ApplicationStatus int                       `json:"application_status"`
+	ApplyDate         time.Time                 `json:"apply_date"`
+	JobRevisionId     *int                      `json:"job_revision_id"`
+	CoverLetter       string                    `json:"cover_letter"`
+	ExpectedSalary    *int64                    `json:"expected_salary"`
+	AvailableFrom     *time.Time                `json:"available_from"`
+	Links             []string                  `json:"links"`
+	Profile           *TalentProfile            `json:"profile,omitempty"`
+	Tags              []string                  `json:"tags,omitempty"`
+	Notes             []ApplicationNote         `json:"notes,omitempty"`
+	Scorecards        []Scorecard               `json:"scorecards,omitempty"`
+	Score             *float64                  `json:"score,omitempty"`
+	Answers           []ApplicationAnswer       `json:"answers,omitempty"`
+	Job               *JobSummary               `json:"job,omitempty"`
+	History           []ApplicationStatusChange `json:"history,omitempty"`
+	Attachments       []Attachment              `json:"attachments,omitempty"`
+	Interview         *Interview                `json:"interview,omitempty"`
+}
+
+// ApplicationStatusChange is one entry of an application's status history.
+// FromStatus is nil for the entry recording the application being sent.
+type ApplicationStatusChange struct {
+	ID            int       `json:"id"`
+	ApplicationId int       `json:"-"`
+	FromStatus    *int      `json:"from_status"`
+	ToStatus      int       `json:"to_status"`
+	ActorId       *int      `json:"actor_id"`
+	Note          string    `json:"note"`
+	ChangeDate    time.Time `json:"change_date"`
+}
+
+// ApplicationNote is a private note an employer keeps on an application.
+type ApplicationNote struct {
+	ID            int       `json:"id"`
+	ApplicationId int       `json:"-"`
+	AuthorId      *int      `json:"author_id"`
+	Body          string    `json:"body"`
+	CreateDate    time.Time `json:"create_date"`
+}
+
+type ApplicationTag struct {
+	ApplicationId int
+	Tag           string
+}
+
+// ScorecardCriterion is one of the criteria applications to a job are scored
+// on. Weight sets how much it counts towards the overall score.
+type ScorecardCriterion struct {
+	ID       int    `json:"id"`
+	JobId    int    `json:"-"`
+	Position int    `json:"position"`
+	Name     string `json:"name"`
+	Weight   int    `json:"weight"`
+}
+
+// CriterionScore is the score a reviewer gave an application on a criterion.
+type CriterionScore struct {
+	ApplicationId int    `json:"-"`
+	ReviewerId    int    `json:"-"`
+	CriterionId   int    `json:"criterion_id"`
+	Name          string `json:"name"`
+	Weight        int    `json:"weight"`
+	Score         int    `json:"score"`
+}
+
+// Scorecard holds one reviewer's scores for an application and their weighted
+// mean.
+type Scorecard struct {
+	ReviewerId int              `json:"reviewer_id"`
+	Scores     []CriterionScore `json:"scores"`
+	Score      float64          `json:"score"`
+}
+
+type ApplicationAnswer struct {
+	ApplicationId int    `json:"-"`
+	QuestionId    int    `json:"question_id"`
+	Question      string `json:"question"`
+	Answer        string `json:"answer"`
+	KnockedOut    bool   `json:"knocked_out"`
+}
+
+type ApplicationCount struct {
+	Created   int `json:"created"`
+	Interview int `json:"interview"`
+	Accepted  int `json:"accepted"`
+	Declined  int `json:"declined"`
+	Withdrawn int `json:"withdrawn"`
+	Total     int `json:"total"`
 }
@@ -0,0 +1,23 @@
+package model
+
+import "time"
+
+// Attachment is a file a talent uploaded, such as a resume or a portfolio.
+// ApplicationId is only set when the attachment is loaded for an application,
+// as the same file can be sent with several applications.
+type Attachment struct {
+	ID            int       `json:"id"`
+	TalentId      int       `json:"talent_id"`
+	ApplicationId int       `json:"-"`
+	Kind          int       `json:"kind"`
+	FileName      string    `json:"file_name"`
+	ContentType   string    `json:"content_type"`
+	Size          int64     `json:"size"`
+	StorageKey    string    `json:"-"`
+	CreateDate    time.Time `json:"create_date"`
+}
+
+type AttachmentURL struct {
+	URL        string    `json:"url"`
+	ExpireDate time.Time `json:"expire_date"`
+}
@@ -0,0 +1,28 @@
+package model
+
+import "time"
+
+type Company struct {
+	ID          int       `json:"id"`
+	EmployerId  int       `json:"employer_id"`
+	Name        string    `json:"name"`
+	Website     string    `json:"website"`
+	Description string    `json:"description"`
+	Size        string    `json:"size"`
+	Industry    string    `json:"industry"`
+	LogoPath    string    `json:"logo_path"`
+	CreateDate  time.Time `json:"create_date"`
+}
+
+type CompanySummary struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Size     string `json:"size"`
+	Industry string `json:"industry"`
+	LogoPath string `json:"logo_path"`
+}
+
+type CompanyPage struct {
+	Company
+	Jobs []Job `json:"jobs"`
+}
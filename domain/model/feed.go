@@ -0,0 +1,7 @@
+package model
+
+type JobFeed struct {
+	Body        []byte `json:"-"`
+	ContentType string `json:"-"`
+	ETag        string `json:"-"`
+}
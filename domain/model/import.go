@@ -0,0 +1,23 @@
+package model
+
+import "time"
+
+type JobImport struct {
+	ID           int            `json:"id"`
+	EmployerId   int            `json:"employer_id"`
+	ImportStatus int            `json:"import_status"`
+	Mode         string         `json:"mode"`
+	TotalRows    int            `json:"total_rows"`
+	InsertedRows int            `json:"inserted_rows"`
+	FailedRows   int            `json:"failed_rows"`
+	Report       []JobImportRow `json:"report"`
+	CreateDate   time.Time      `json:"create_date"`
+	FinishDate   *time.Time     `json:"finish_date"`
+}
+
+type JobImportRow struct {
+	Row      int      `json:"row"`
+	JobId    int      `json:"job_id,omitempty"`
+	Errors   []string `json:"errors,omitempty"`
+	Warnings []string `json:"warnings,omitempty"`
+}
@@ -0,0 +1,30 @@
+package model
+
+import "time"
+
+// InterviewSlot is a time an employer is available to interview talents who
+// applied to the job. ApplicationId is set once the slot is booked.
+type InterviewSlot struct {
+	ID            int       `json:"id"`
+	JobId         int       `json:"job_id"`
+	EmployerId    int       `json:"-"`
+	StartDate     time.Time `json:"start_date"`
+	EndDate       time.Time `json:"end_date"`
+	Location      string    `json:"location"`
+	ApplicationId *int      `json:"application_id,omitempty"`
+	CreateDate    time.Time `json:"create_date"`
+}
+
+// Interview is the slot booked for an application. It keeps the time and
+// location of the slot, so a cancelled interview can still be sent out after
+// its slot is gone. Sequence goes up on every reschedule and cancellation.
+type Interview struct {
+	ApplicationId   int       `json:"application_id"`
+	SlotId          *int      `json:"slot_id"`
+	StartDate       time.Time `json:"start_date"`
+	EndDate         time.Time `json:"end_date"`
+	Location        string    `json:"location"`
+	InterviewStatus int       `json:"interview_status"`
+	Sequence        int       `json:"sequence"`
+	UpdateDate      time.Time `json:"update_date"`
+}
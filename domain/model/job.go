@@ -3,10 +3,110 @@ package model
 import "time"
 
 type Job struct {
-	ID          int       `json:"id"`
-	EmployerId  int       `json:"employer_id"`
-	Title       string    `json:"title"`
-	Description string    `json:"description"`
-	Requirement string    `json:"requirement"`
-	CreateDate  time.Time `json:"create_date"`
+	ID               int              `json:"id"`
+	EmployerId       int              `json:"employer_id"`
+	Title            string           `json:"title"`
+	Description      string           `json:"description"`
+	Requirement      string           `json:"requirement"`
+	DescriptionHTML  string           `json:"description_html,omitempty"`
+	RequirementHTML  string           `json:"requirement_html,omitempty"`
+	CreateDate       time.Time        `json:"create_date"`
+	Skills           []string         `json:"skills"`
+	Company          *CompanySummary  `json:"company"`
+	JobStatus        int              `json:"job_status"`
+	ExpireDate       *time.Time       `json:"expire_date"`
+	Openings         *int             `json:"openings"`
+	MaxApplications  *int             `json:"max_applications"`
+	ModerationStatus int              `json:"moderation_status"`
+	City             *string          `json:"city"`
+	Latitude         *float64         `json:"latitude"`
+	Longitude        *float64         `json:"longitude"`
+	DistanceKm       *float64         `json:"distance_km,omitempty"`
+	Language         string           `json:"language"`
+	Languages        []string         `json:"languages,omitempty"`
+	RequiredFields   []string         `json:"required_fields"`
+	Questions        []JobQuestion    `json:"questions,omitempty"`
+	ModerationFlags  []string         `json:"-"`
+	Translations     []JobTranslation `json:"-"`
+}
+
+// JobSummary is the part of a job shown next to an application, enough for a
+// talent to recognise the posting without fetching it.
+type JobSummary struct {
+	ID         int             `json:"id"`
+	Title      string          `json:"title"`
+	Company    *CompanySummary `json:"company"`
+	City       *string         `json:"city"`
+	JobStatus  int             `json:"job_status"`
+	ExpireDate *time.Time      `json:"expire_date"`
+	IsClosed   bool            `json:"is_closed"`
+	IsExpired  bool            `json:"is_expired"`
+}
+
+type JobTranslation struct {
+	JobId       int    `json:"-"`
+	Language    string `json:"language"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Requirement string `json:"requirement"`
+}
+
+type InsertJobResult struct {
+	JobId            int      `json:"job_id"`
+	ModerationStatus int      `json:"moderation_status"`
+	Warnings         []string `json:"warnings"`
+}
+
+type JobQuestion struct {
+	ID              int      `json:"id"`
+	JobId           int      `json:"job_id"`
+	Position        int      `json:"position"`
+	Question        string   `json:"question"`
+	QuestionType    int      `json:"question_type"`
+	Options         []string `json:"options"`
+	KnockoutAnswers []string `json:"knockout_answers,omitempty"`
+	KnockoutMin     *float64 `json:"knockout_min,omitempty"`
+	KnockoutMax     *float64 `json:"knockout_max,omitempty"`
+}
+
+// ModerationJob is a job waiting in the moderation queue with every flag
+// raised against it since it entered the queue.
+type ModerationJob struct {
+	Job       Job       `json:"job"`
+	Flags     []string  `json:"flags"`
+	QueueDate time.Time `json:"queue_date"`
+}
+
+type JobFilter struct {
+	Skills     []string
+	EmployerId int
+	OpenOnly   bool
+	Bounds     *GeoBounds
+}
+
+// GeoBounds limits jobs to a latitude and longitude range. MinLongitude is
+// greater than MaxLongitude when the range crosses the antimeridian.
+type GeoBounds struct {
+	MinLatitude  float64
+	MaxLatitude  float64
+	MinLongitude float64
+	MaxLongitude float64
+}
+
+type SavedJob struct {
+	Job       Job       `json:"job"`
+	SaveDate  time.Time `json:"save_date"`
+	IsClosed  bool      `json:"is_closed"`
+	IsExpired bool      `json:"is_expired"`
+}
+
+type EmployerJob struct {
+	Job
+	ApplicationCount ApplicationCount `json:"application_count"`
+}
+
+type RecommendedJob struct {
+	Job     Job      `json:"job"`
+	Score   float64  `json:"score"`
+	Reasons []string `json:"reasons"`
 }
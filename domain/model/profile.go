@@ -0,0 +1,33 @@
+package model
+
+import "time"
+
+// TalentProfile is what a talent tells employers about themselves. Skills are
+// the talent's declared skills, which are edited on their own.
+type TalentProfile struct {
+	TalentId    int              `json:"talent_id"`
+	Headline    string           `json:"headline"`
+	Summary     string           `json:"summary"`
+	Experiences []WorkExperience `json:"experiences"`
+	Educations  []Education      `json:"educations"`
+	Skills      []string         `json:"skills"`
+	Links       []string         `json:"links"`
+	Visibility  int              `json:"visibility"`
+	UpdateDate  time.Time        `json:"update_date"`
+}
+
+type WorkExperience struct {
+	Title       string     `json:"title"`
+	Company     string     `json:"company"`
+	StartDate   time.Time  `json:"start_date"`
+	EndDate     *time.Time `json:"end_date"`
+	Description string     `json:"description"`
+}
+
+type Education struct {
+	School       string     `json:"school"`
+	Degree       string     `json:"degree"`
+	FieldOfStudy string     `json:"field_of_study"`
+	StartDate    time.Time  `json:"start_date"`
+	EndDate      *time.Time `json:"end_date"`
+}
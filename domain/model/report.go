@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+type JobReport struct {
+	ID           int        `json:"id"`
+	JobId        int        `json:"job_id"`
+	JobTitle     string     `json:"job_title"`
+	TalentId     int        `json:"talent_id"`
+	Category     int        `json:"category"`
+	Details      string     `json:"details"`
+	ReportStatus int        `json:"report_status"`
+	CreateDate   time.Time  `json:"create_date"`
+	ReviewDate   *time.Time `json:"review_date"`
+	ReviewerId   *int       `json:"reviewer_id"`
+	Resolution   *string    `json:"resolution"`
+}
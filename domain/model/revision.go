@@ -0,0 +1,32 @@
+package model
+
+import "time"
+
+type JobRevision struct {
+	ID          int        `json:"id"`
+	JobId       int        `json:"job_id"`
+	Revision    int        `json:"revision"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Requirement string     `json:"requirement"`
+	Skills      []string   `json:"skills"`
+	ExpireDate  *time.Time `json:"expire_date"`
+	CreateDate  time.Time  `json:"create_date"`
+}
+
+type JobRevisionDiff struct {
+	JobId        int                 `json:"job_id"`
+	FromRevision int                 `json:"from_revision"`
+	ToRevision   int                 `json:"to_revision"`
+	Changes      []JobRevisionChange `json:"changes"`
+}
+
+type JobRevisionChange struct {
+	Field string     `json:"field"`
+	Lines []DiffLine `json:"lines"`
+}
+
+type DiffLine struct {
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
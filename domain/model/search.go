@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+type SavedSearch struct {
+	ID          int       `json:"id"`
+	TalentId    int       `json:"talent_id"`
+	TalentEmail string    `json:"-"`
+	Name        string    `json:"name"`
+	Keywords    string    `json:"keywords"`
+	Skills      []string  `json:"skills"`
+	CompanyId   *int      `json:"company_id"`
+	Frequency   int       `json:"frequency"`
+	CreateDate  time.Time `json:"create_date"`
+}
+
+type SavedSearchMatch struct {
+	SavedSearch SavedSearch `json:"saved_search"`
+	Job         Job         `json:"job"`
+	MatchDate   time.Time   `json:"match_date"`
+}
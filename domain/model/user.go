@@ -1,10 +1,11 @@
 package model
 
 type User struct {
-	ID       int    `json:"id"`
-	Email    string `json:"email"`
-	Password string `json:"password"`
-	Role     int    `json:"role"`
+	ID         int    `json:"id"`
+	Email      string `json:"email"`
+	Password   string `json:"password"`
+	Role       int    `json:"role"`
+	IsVerified bool   `json:"is_verified"`
 }
 
 type UserToken struct {
@@ -1,5 +1,7 @@
 package request
 
+import "time"
+
 type UserRegisterRequest struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
@@ -22,20 +24,253 @@ type UserRefreshTokenRequest struct {
 	Role         int    `json:"role"`
 }
 
+type SearchJobRequest struct {
+	Skills    []string `json:"skills"`
+	Latitude  *float64 `json:"lat"`
+	Longitude *float64 `json:"lon"`
+	RadiusKm  *float64 `json:"radius_km"`
+	Sort      string   `json:"sort"`
+	Languages []string `json:"languages"`
+}
+
 type SearchJobByIdRequest struct {
-	JobId int `json:"job_id"`
+	JobId     int      `json:"job_id"`
+	Languages []string `json:"languages"`
 }
 
 type InsertJobRequest struct {
-	EmployerId  int    `json:"employer_id"`
+	EmployerId      int                        `json:"employer_id"`
+	Title           string                     `json:"title"`
+	Description     string                     `json:"description"`
+	Requirement     string                     `json:"requirement"`
+	Skills          []string                   `json:"skills"`
+	ExpireDate      *time.Time                 `json:"expire_date"`
+	Openings        *int                       `json:"openings"`
+	MaxApplications *int                       `json:"max_applications"`
+	City            string                     `json:"city"`
+	Language        string                     `json:"language"`
+	Translations    []JobTranslationRequest    `json:"translations"`
+	RequiredFields  []string                   `json:"required_fields"`
+	Questions       []InsertJobQuestionRequest `json:"questions"`
+}
+
+type JobTranslationRequest struct {
+	Language    string `json:"language"`
 	Title       string `json:"title"`
 	Description string `json:"description"`
 	Requirement string `json:"requirement"`
 }
 
-type SearchApplicationByJobRequest struct {
+type InsertJobQuestionRequest struct {
+	Question        string   `json:"question"`
+	QuestionType    int      `json:"question_type"`
+	Options         []string `json:"options"`
+	KnockoutAnswers []string `json:"knockout_answers"`
+	KnockoutMin     *float64 `json:"knockout_min"`
+	KnockoutMax     *float64 `json:"knockout_max"`
+}
+
+type UpdateJobRequest struct {
+	JobId           int                     `json:"job_id"`
+	EmployerId      int                     `json:"employer_id"`
+	Title           string                  `json:"title"`
+	Description     string                  `json:"description"`
+	Requirement     string                  `json:"requirement"`
+	Skills          []string                `json:"skills"`
+	ExpireDate      *time.Time              `json:"expire_date"`
+	Openings        *int                    `json:"openings"`
+	MaxApplications *int                    `json:"max_applications"`
+	City            string                  `json:"city"`
+	Language        string                  `json:"language"`
+	Translations    []JobTranslationRequest `json:"translations"`
+	RequiredFields  []string                `json:"required_fields"`
+}
+
+type SearchModerationJobRequest struct {
+	Page  int `json:"page"`
+	Limit int `json:"limit"`
+}
+
+type ModerateJobRequest struct {
+	JobId    int    `json:"job_id"`
+	AdminId  int    `json:"admin_id"`
+	Decision int    `json:"decision"`
+	Reason   string `json:"reason"`
+}
+
+type InsertJobReportRequest struct {
+	JobId    int    `json:"job_id"`
+	TalentId int    `json:"talent_id"`
+	Category int    `json:"category"`
+	Details  string `json:"details"`
+}
+
+type SearchJobReportRequest struct {
+	ReportStatus int `json:"report_status"`
+	Page         int `json:"page"`
+	Limit        int `json:"limit"`
+}
+
+type ResolveJobReportRequest struct {
+	ReportId     int    `json:"report_id"`
+	AdminId      int    `json:"admin_id"`
+	ReportStatus int    `json:"report_status"`
+	Resolution   string `json:"resolution"`
+}
+
+type VerifyEmployerRequest struct {
+	EmployerId int `json:"employer_id"`
+}
+
+type SearchJobRevisionRequest struct {
+	JobId  int `json:"job_id"`
+	UserId int `json:"user_id"`
+	Role   int `json:"role"`
+}
+
+type SearchJobRevisionDiffRequest struct {
+	JobId        int `json:"job_id"`
+	UserId       int `json:"user_id"`
+	Role         int `json:"role"`
+	FromRevision int `json:"from_revision"`
+	ToRevision   int `json:"to_revision"`
+}
+
+type ImportJobRequest struct {
+	EmployerId int    `json:"employer_id"`
+	Format     string `json:"format"`
+	Mode       string `json:"mode"`
+	Data       []byte `json:"-"`
+}
+
+type SearchJobImportRequest struct {
+	ImportId   int `json:"import_id"`
+	EmployerId int `json:"employer_id"`
+}
+
+type SearchJobFeedRequest struct {
+	Format string `json:"format"`
+}
+
+type UpdateJobStatusRequest struct {
 	JobId      int `json:"job_id"`
 	EmployerId int `json:"employer_id"`
+	Status     int `json:"status"`
+}
+
+type SaveJobRequest struct {
+	JobId    int `json:"job_id"`
+	TalentId int `json:"talent_id"`
+}
+
+type SearchSavedJobRequest struct {
+	TalentId int `json:"talent_id"`
+	Page     int `json:"page"`
+	Limit    int `json:"limit"`
+}
+
+type SearchJobByEmployerRequest struct {
+	EmployerId int    `json:"employer_id"`
+	Page       int    `json:"page"`
+	Limit      int    `json:"limit"`
+	SortBy     string `json:"sort_by"`
+	SortOrder  string `json:"sort_order"`
+}
+
+type SearchSkillRequest struct {
+	Query string `json:"query"`
+	Limit int    `json:"limit"`
+}
+
+type UpsertCompanyRequest struct {
+	EmployerId  int    `json:"employer_id"`
+	Name        string `json:"name"`
+	Website     string `json:"website"`
+	Description string `json:"description"`
+	Size        string `json:"size"`
+	Industry    string `json:"industry"`
+	LogoPath    string `json:"logo_path"`
+}
+
+type SearchCompanyByIdRequest struct {
+	CompanyId int `json:"company_id"`
+}
+
+type SearchCompanyByEmployerRequest struct {
+	EmployerId int `json:"employer_id"`
+}
+
+type UpsertTalentProfileRequest struct {
+	TalentId    int                     `json:"talent_id"`
+	Headline    string                  `json:"headline"`
+	Summary     string                  `json:"summary"`
+	Experiences []WorkExperienceRequest `json:"experiences"`
+	Educations  []EducationRequest      `json:"educations"`
+	Links       []string                `json:"links"`
+	Visibility  int                     `json:"visibility"`
+}
+
+type WorkExperienceRequest struct {
+	Title       string     `json:"title"`
+	Company     string     `json:"company"`
+	StartDate   *time.Time `json:"start_date"`
+	EndDate     *time.Time `json:"end_date"`
+	Description string     `json:"description"`
+}
+
+type EducationRequest struct {
+	School       string     `json:"school"`
+	Degree       string     `json:"degree"`
+	FieldOfStudy string     `json:"field_of_study"`
+	StartDate    *time.Time `json:"start_date"`
+	EndDate      *time.Time `json:"end_date"`
+}
+
+type SearchTalentProfileRequest struct {
+	TalentId   int `json:"talent_id"`
+	EmployerId int `json:"employer_id"`
+}
+
+type DeleteTalentProfileRequest struct {
+	TalentId int `json:"talent_id"`
+}
+
+type InsertSavedSearchRequest struct {
+	TalentId  int      `json:"talent_id"`
+	Name      string   `json:"name"`
+	Keywords  string   `json:"keywords"`
+	Skills    []string `json:"skills"`
+	CompanyId *int     `json:"company_id"`
+	Frequency int      `json:"frequency"`
+}
+
+type SearchSavedSearchRequest struct {
+	TalentId int `json:"talent_id"`
+}
+
+type DeleteSavedSearchRequest struct {
+	SavedSearchId int `json:"saved_search_id"`
+	TalentId      int `json:"talent_id"`
+}
+
+type UpdateTalentSkillsRequest struct {
+	TalentId int      `json:"talent_id"`
+	Skills   []string `json:"skills"`
+}
+
+type SearchTalentSkillsRequest struct {
+	TalentId int `json:"talent_id"`
+}
+
+type SearchRecommendedJobRequest struct {
+	TalentId int `json:"talent_id"`
+	Limit    int `json:"limit"`
+}
+
+type SearchApplicationByJobRequest struct {
+	JobId            int  `json:"job_id"`
+	EmployerId       int  `json:"employer_id"`
+	IncludeWithdrawn bool `json:"include_withdrawn"`
 }
 
 type SearchApplicationByIdRequest struct {
@@ -44,17 +279,151 @@ type SearchApplicationByIdRequest struct {
 	Role          int `json:"role"`
 }
 
-type SearchApplicationhByTalentRequest struct {
-	TalentId int `json:"talent_id"`
+type SearchApplicationByTalentRequest struct {
+	TalentId int   `json:"talent_id"`
+	Statuses []int `json:"statuses"`
+	Page     int   `json:"page"`
+	Limit    int   `json:"limit"`
 }
 
 type InsertApplicationRequest struct {
-	JobId    int `json:"job_id"`
+	JobId          int                              `json:"job_id"`
+	TalentId       int                              `json:"talent_id"`
+	CoverLetter    string                           `json:"cover_letter"`
+	ExpectedSalary *int64                           `json:"expected_salary"`
+	AvailableFrom  *time.Time                       `json:"available_from"`
+	Links          []string                         `json:"links"`
+	Answers        []InsertApplicationAnswerRequest `json:"answers"`
+	AttachmentIds  []int                            `json:"attachment_ids"`
+}
+
+type InsertApplicationAnswerRequest struct {
+	QuestionId int    `json:"question_id"`
+	Answer     string `json:"answer"`
+}
+
+type UploadAttachmentRequest struct {
+	TalentId int    `json:"talent_id"`
+	Kind     int    `json:"kind"`
+	FileName string `json:"file_name"`
+	Data     []byte `json:"-"`
+}
+
+type SearchAttachmentRequest struct {
 	TalentId int `json:"talent_id"`
 }
 
-type UpdateApplicationStatusRequest struct {
+type SearchAttachmentURLRequest struct {
+	AttachmentId int `json:"attachment_id"`
+	UserId       int `json:"user_id"`
+	Role         int `json:"role"`
+}
+
+type DownloadAttachmentRequest struct {
+	AttachmentId int    `json:"attachment_id"`
+	Expires      int64  `json:"expires"`
+	Signature    string `json:"signature"`
+}
+
+type WithdrawApplicationRequest struct {
+	ApplicationId int    `json:"application_id"`
+	TalentId      int    `json:"talent_id"`
+	Note          string `json:"note"`
+}
+
+type InsertApplicationNoteRequest struct {
+	ApplicationId int    `json:"application_id"`
+	EmployerId    int    `json:"employer_id"`
+	Body          string `json:"body"`
+}
+
+type DeleteApplicationNoteRequest struct {
 	ApplicationId int `json:"application_id"`
+	NoteId        int `json:"note_id"`
 	EmployerId    int `json:"employer_id"`
-	Status        int `json:"status"`
+}
+
+type UpdateApplicationTagsRequest struct {
+	ApplicationId int      `json:"application_id"`
+	EmployerId    int      `json:"employer_id"`
+	Tags          []string `json:"tags"`
+}
+
+type SearchScorecardRequest struct {
+	JobId      int `json:"job_id"`
+	EmployerId int `json:"employer_id"`
+}
+
+type UpdateScorecardRequest struct {
+	JobId      int                         `json:"job_id"`
+	EmployerId int                         `json:"employer_id"`
+	Criteria   []ScorecardCriterionRequest `json:"criteria"`
+}
+
+type ScorecardCriterionRequest struct {
+	Name   string `json:"name"`
+	Weight int    `json:"weight"`
+}
+
+type UpdateApplicationScorecardRequest struct {
+	ApplicationId int                     `json:"application_id"`
+	ReviewerId    int                     `json:"reviewer_id"`
+	Scores        []CriterionScoreRequest `json:"scores"`
+}
+
+type CriterionScoreRequest struct {
+	CriterionId int `json:"criterion_id"`
+	Score       int `json:"score"`
+}
+
+type InsertInterviewSlotsRequest struct {
+	JobId      int                    `json:"job_id"`
+	EmployerId int                    `json:"employer_id"`
+	Slots      []InterviewSlotRequest `json:"slots"`
+}
+
+type InterviewSlotRequest struct {
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+	Location  string    `json:"location"`
+}
+
+type SearchInterviewSlotsRequest struct {
+	JobId      int `json:"job_id"`
+	EmployerId int `json:"employer_id"`
+}
+
+type DeleteInterviewSlotRequest struct {
+	SlotId     int `json:"slot_id"`
+	EmployerId int `json:"employer_id"`
+}
+
+type SearchAvailableInterviewSlotsRequest struct {
+	ApplicationId int `json:"application_id"`
+	TalentId      int `json:"talent_id"`
+}
+
+type BookInterviewRequest struct {
+	ApplicationId int `json:"application_id"`
+	TalentId      int `json:"talent_id"`
+	SlotId        int `json:"slot_id"`
+}
+
+type SearchInterviewRequest struct {
+	ApplicationId int `json:"application_id"`
+	UserId        int `json:"user_id"`
+	Role          int `json:"role"`
+}
+
+type CancelInterviewRequest struct {
+	ApplicationId int `json:"application_id"`
+	UserId        int `json:"user_id"`
+	Role          int `json:"role"`
+}
+
+type UpdateApplicationStatusRequest struct {
+	ApplicationId int    `json:"application_id"`
+	EmployerId    int    `json:"employer_id"`
+	Status        int    `json:"status"`
+	Note          string `json:"note"`
 }
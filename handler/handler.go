@@ -46,6 +46,9 @@ func (h *handler) StartRoute() *http.Server {
 	r.Post("/register", h.controller.Register)
 	r.Post("/logout", h.controller.Logout)
 	r.Post("/refresh-token", h.controller.RefreshToken)
+	r.Get("/company/{companyId}", h.controller.GetCompanyPage)
+	r.Get("/feeds/jobs.{format}", h.controller.GetJobFeed)
+	r.Get("/attachment/{attachmentId}/download", h.controller.DownloadAttachment)
 
 	r.Group(func(r chi.Router) {
 		r.Use(middleware.Authorize([]int{enum.TalentRole}))
@@ -53,19 +56,72 @@ func (h *handler) StartRoute() *http.Server {
 		r.Get("/jobs", h.controller.GetAllJob)
 		r.Get("/job/{jobId}", h.controller.GetJobById)
 		r.Post("/job/{jobId}", h.controller.InsertApplication)
+		r.Post("/job/{jobId}/save", h.controller.SaveJob)
+		r.Delete("/job/{jobId}/save", h.controller.UnsaveJob)
+		r.Post("/job/{jobId}/report", h.controller.ReportJob)
+		r.Get("/me/saved-jobs", h.controller.GetSavedJobs)
+		r.Get("/me/applications", h.controller.GetMyApplications)
+		r.Post("/application/{applicationId}/withdraw", h.controller.WithdrawApplication)
+		r.Get("/application/{applicationId}/interview-slots", h.controller.GetAvailableInterviewSlots)
+		r.Put("/application/{applicationId}/interview", h.controller.BookInterview)
+		r.Post("/me/attachments", h.controller.UploadAttachment)
+		r.Get("/me/attachments", h.controller.GetAttachments)
+		r.Post("/me/saved-searches", h.controller.InsertSavedSearch)
+		r.Get("/me/saved-searches", h.controller.GetSavedSearches)
+		r.Delete("/me/saved-searches/{savedSearchId}", h.controller.DeleteSavedSearch)
+		r.Get("/me/profile", h.controller.GetProfile)
+		r.Put("/me/profile", h.controller.UpsertProfile)
+		r.Delete("/me/profile", h.controller.DeleteProfile)
+		r.Get("/me/skills", h.controller.GetTalentSkills)
+		r.Put("/me/skills", h.controller.UpdateTalentSkills)
+		r.Get("/me/recommended-jobs", h.controller.GetRecommendedJobs)
 	})
 
 	r.Group(func(r chi.Router) {
 		r.Use(middleware.Authorize([]int{enum.EmployerRole}))
 
 		r.Post("/job", h.controller.InsertJob)
+		r.Put("/job/{jobId}", h.controller.UpdateJob)
+		r.Post("/jobs/import", h.controller.ImportJobs)
+		r.Get("/jobs/import/{importId}", h.controller.GetJobImport)
+		r.Put("/job/{jobId}/status", h.controller.UpdateJobStatus)
+		r.Get("/employer/jobs", h.controller.GetJobsByEmployerId)
 		r.Get("/job/{jobId}/applications", h.controller.GetApplicationsByJobId)
 		r.Put("/application/{applicationId}", h.controller.UpdateApplicationStatus)
+		r.Post("/application/{applicationId}/notes", h.controller.InsertApplicationNote)
+		r.Delete("/application/{applicationId}/notes/{noteId}", h.controller.DeleteApplicationNote)
+		r.Put("/application/{applicationId}/tags", h.controller.UpdateApplicationTags)
+		r.Put("/application/{applicationId}/scorecard", h.controller.UpdateApplicationScorecard)
+		r.Get("/job/{jobId}/scorecard", h.controller.GetScorecard)
+		r.Put("/job/{jobId}/scorecard", h.controller.UpdateScorecard)
+		r.Get("/job/{jobId}/interview-slots", h.controller.GetInterviewSlots)
+		r.Post("/job/{jobId}/interview-slots", h.controller.InsertInterviewSlots)
+		r.Delete("/interview-slot/{slotId}", h.controller.DeleteInterviewSlot)
+		r.Get("/employer/company", h.controller.GetCompanyByEmployerId)
+		r.Put("/employer/company", h.controller.UpsertCompany)
+		r.Get("/talent/{talentId}/profile", h.controller.GetTalentProfile)
 	})
 
 	r.Group(func(r chi.Router) {
 		r.Use(middleware.Authorize([]int{enum.TalentRole, enum.EmployerRole}))
 		r.Get("/application/{applicationId}", h.controller.GetApplicationById)
+		r.Delete("/application/{applicationId}/interview", h.controller.CancelInterview)
+		r.Get("/application/{applicationId}/interview.ics", h.controller.GetInterviewInvite)
+		r.Get("/attachment/{attachmentId}/url", h.controller.GetAttachmentURL)
+		r.Get("/job/{jobId}/revisions", h.controller.GetJobRevisions)
+		r.Get("/job/{jobId}/revisions/diff", h.controller.GetJobRevisionDiff)
+		r.Get("/skills", h.controller.SearchSkills)
+	})
+
+	r.Group(func(r chi.Router) {
+		r.Use(middleware.Authorize([]int{enum.AdminRole}))
+
+		r.Get("/admin/moderation/jobs", h.controller.GetModerationJobs)
+		r.Post("/admin/moderation/job/{jobId}/approve", h.controller.ApproveJob)
+		r.Post("/admin/moderation/job/{jobId}/reject", h.controller.RejectJob)
+		r.Put("/admin/employer/{employerId}/verify", h.controller.VerifyEmployer)
+		r.Get("/admin/reports", h.controller.GetJobReports)
+		r.Put("/admin/report/{reportId}", h.controller.ResolveJobReport)
 	})
 
 	srv := &http.Server{
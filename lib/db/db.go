@@ -34,6 +34,11 @@ func createTableIfNotExists(db *sql.DB, tableName string, schema string) error {
 	return nil
 }
 
+func addColumnIfNotExists(db *sql.DB, tableName, columnName, definition string) error {
+	_, err := db.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN IF NOT EXISTS %s %s", tableName, columnName, definition))
+	return err
+}
+
 func Connect(timeout time.Duration, dbname, host, port, user, password string) (*sql.DB, error) {
 	connStr := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
 		host, port, user, password, dbname)
@@ -62,6 +67,33 @@ func Connect(timeout time.Duration, dbname, host, port, user, password string) (
 		{userTokensTable, userTokensTableSchema},
 		{jobsTable, jobsTableSchema},
 		{applicationsTable, applicationsTableSchema},
+		{skillsTable, skillsTableSchema},
+		{skillAliasesTable, skillAliasesTableSchema},
+		{jobSkillsTable, jobSkillsTableSchema},
+		{companiesTable, companiesTableSchema},
+		{savedJobsTable, savedJobsTableSchema},
+		{savedSearchesTable, savedSearchesTableSchema},
+		{savedSearchMatchesTable, savedSearchMatchesTableSchema},
+		{talentSkillsTable, talentSkillsTableSchema},
+		{jobQuestionsTable, jobQuestionsTableSchema},
+		{applicationAnswersTable, applicationAnswersTableSchema},
+		{jobImportsTable, jobImportsTableSchema},
+		{jobRevisionsTable, jobRevisionsTableSchema},
+		{jobModerationsTable, jobModerationsTableSchema},
+		{jobTranslationsTable, jobTranslationsTableSchema},
+		{jobReportsTable, jobReportsTableSchema},
+		{applicationStatusHistoryTable, applicationStatusHistoryTableSchema},
+		{attachmentsTable, attachmentsTableSchema},
+		{applicationAttachmentsTable, applicationAttachmentsTableSchema},
+		{talentProfilesTable, talentProfilesTableSchema},
+		{talentExperiencesTable, talentExperiencesTableSchema},
+		{talentEducationsTable, talentEducationsTableSchema},
+		{applicationNotesTable, applicationNotesTableSchema},
+		{applicationTagsTable, applicationTagsTableSchema},
+		{scorecardCriteriaTable, scorecardCriteriaTableSchema},
+		{applicationScoresTable, applicationScoresTableSchema},
+		{interviewSlotsTable, interviewSlotsTableSchema},
+		{interviewsTable, interviewsTableSchema},
 	}
 
 	for _, table := range tables {
@@ -72,5 +104,21 @@ func Connect(timeout time.Duration, dbname, host, port, user, password string) (
 		}
 	}
 
+	for _, column := range tableColumns {
+		err = addColumnIfNotExists(db, column.table, column.column, column.definition)
+		if err != nil {
+			log.Printf("Error adding column %s.%s: %s\n", column.table, column.column, err)
+			return nil, err
+		}
+	}
+
+	for _, statement := range migrationStatements {
+		_, err = db.Exec(statement)
+		if err != nil {
+			log.Printf("Error running migration %q: %s\n", statement, err)
+			return nil, err
+		}
+	}
+
 	return db, nil
 }
@@ -5,6 +5,35 @@ const (
 	userTokensTable   = "user_tokens"
 	jobsTable         = "jobs"
 	applicationsTable = "applications"
+	skillsTable       = "skills"
+	skillAliasesTable = "skill_aliases"
+	jobSkillsTable    = "job_skills"
+	companiesTable    = "companies"
+	savedJobsTable    = "saved_jobs"
+
+	savedSearchesTable      = "saved_searches"
+	savedSearchMatchesTable = "saved_search_matches"
+	talentSkillsTable       = "talent_skills"
+	jobQuestionsTable       = "job_questions"
+	applicationAnswersTable = "application_answers"
+	jobImportsTable         = "job_imports"
+	jobRevisionsTable       = "job_revisions"
+	jobModerationsTable     = "job_moderations"
+	jobTranslationsTable    = "job_translations"
+	jobReportsTable         = "job_reports"
+
+	applicationStatusHistoryTable = "application_status_history"
+	attachmentsTable              = "attachments"
+	applicationAttachmentsTable   = "application_attachments"
+	talentProfilesTable           = "talent_profiles"
+	talentExperiencesTable        = "talent_experiences"
+	talentEducationsTable         = "talent_educations"
+	applicationNotesTable         = "application_notes"
+	applicationTagsTable          = "application_tags"
+	scorecardCriteriaTable        = "scorecard_criteria"
+	applicationScoresTable        = "application_scores"
+	interviewSlotsTable           = "interview_slots"
+	interviewsTable               = "interviews"
 )
 
 const (
@@ -13,7 +42,8 @@ CREATE TABLE IF NOT EXISTS users (
     id SERIAL PRIMARY KEY,
     email VARCHAR(255) UNIQUE NOT NULL,
     password VARCHAR(255) NOT NULL,
-    role INTEGER NOT NULL
+    role INTEGER NOT NULL,
+    is_verified BOOLEAN NOT NULL DEFAULT FALSE
 );`
 
 	userTokensTableSchema = `
@@ -32,7 +62,18 @@ CREATE TABLE IF NOT EXISTS jobs (
     title VARCHAR(255) NOT NULL,
     description TEXT NOT NULL,
     requirement TEXT NOT NULL,
-    create_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+    create_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    job_status INTEGER NOT NULL DEFAULT 1,
+    expire_date TIMESTAMP,
+    openings INTEGER,
+    max_applications INTEGER,
+    moderation_status INTEGER NOT NULL DEFAULT 1,
+    city VARCHAR(255),
+    latitude DOUBLE PRECISION,
+    longitude DOUBLE PRECISION,
+    language VARCHAR(35) NOT NULL DEFAULT 'en',
+    required_fields TEXT[] NOT NULL DEFAULT '{}',
+    auto_closed BOOLEAN NOT NULL DEFAULT FALSE
 );`
 
 	applicationsTableSchema = `
@@ -42,6 +83,351 @@ CREATE TABLE IF NOT EXISTS applications (
     talent_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
     application_status INTEGER NOT NULL DEFAULT 1,
     apply_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    cover_letter TEXT NOT NULL DEFAULT '',
+    expected_salary BIGINT,
+    available_from DATE,
+    links TEXT[] NOT NULL DEFAULT '{}',
+    profile_snapshot JSONB
+);`
+
+	skillsTableSchema = `
+CREATE TABLE IF NOT EXISTS skills (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) UNIQUE NOT NULL
+);
+
+INSERT INTO skills (name) VALUES
+    ('go'), ('postgresql'), ('javascript'), ('typescript'), ('python'),
+    ('kubernetes'), ('react'), ('node.js'), ('machine learning'), ('amazon web services')
+ON CONFLICT (name) DO NOTHING;`
+
+	skillAliasesTableSchema = `
+CREATE TABLE IF NOT EXISTS skill_aliases (
+    alias VARCHAR(100) PRIMARY KEY,
+    skill_id INTEGER NOT NULL REFERENCES skills(id) ON DELETE CASCADE
+);
+
+INSERT INTO skill_aliases (alias, skill_id)
+SELECT a.alias, s.id FROM (VALUES
+    ('golang', 'go'), ('postgres', 'postgresql'), ('psql', 'postgresql'),
+    ('js', 'javascript'), ('ts', 'typescript'), ('py', 'python'),
+    ('k8s', 'kubernetes'), ('reactjs', 'react'), ('react.js', 'react'),
+    ('nodejs', 'node.js'), ('node', 'node.js'), ('ml', 'machine learning'),
+    ('aws', 'amazon web services')
+) AS a(alias, name) JOIN skills s ON s.name = a.name
+ON CONFLICT (alias) DO NOTHING;`
+
+	jobSkillsTableSchema = `
+CREATE TABLE IF NOT EXISTS job_skills (
+    job_id INTEGER REFERENCES jobs(id) ON DELETE CASCADE,
+    skill_id INTEGER REFERENCES skills(id) ON DELETE CASCADE,
+    PRIMARY KEY (job_id, skill_id)
+);`
+
+	companiesTableSchema = `
+CREATE TABLE IF NOT EXISTS companies (
+    id SERIAL PRIMARY KEY,
+    employer_id INTEGER UNIQUE NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    website VARCHAR(255) NOT NULL DEFAULT '',
+    description TEXT NOT NULL DEFAULT '',
+    size VARCHAR(20) NOT NULL DEFAULT '',
+    industry VARCHAR(100) NOT NULL DEFAULT '',
+    logo_path VARCHAR(255) NOT NULL DEFAULT '',
+    create_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);`
+
+	savedJobsTableSchema = `
+CREATE TABLE IF NOT EXISTS saved_jobs (
+    talent_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
+    job_id INTEGER REFERENCES jobs(id) ON DELETE CASCADE,
+    save_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (talent_id, job_id)
+);`
+
+	savedSearchesTableSchema = `
+CREATE TABLE IF NOT EXISTS saved_searches (
+    id SERIAL PRIMARY KEY,
+    talent_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    keywords VARCHAR(255) NOT NULL DEFAULT '',
+    skills TEXT[] NOT NULL DEFAULT '{}',
+    company_id INTEGER REFERENCES companies(id) ON DELETE CASCADE,
+    frequency INTEGER NOT NULL DEFAULT 1,
+    create_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);`
+
+	savedSearchMatchesTableSchema = `
+CREATE TABLE IF NOT EXISTS saved_search_matches (
+    saved_search_id INTEGER REFERENCES saved_searches(id) ON DELETE CASCADE,
+    job_id INTEGER REFERENCES jobs(id) ON DELETE CASCADE,
+    match_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    notify_date TIMESTAMP,
+    PRIMARY KEY (saved_search_id, job_id)
+);`
+
+	talentSkillsTableSchema = `
+CREATE TABLE IF NOT EXISTS talent_skills (
+    talent_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
+    skill_id INTEGER REFERENCES skills(id) ON DELETE CASCADE,
+    PRIMARY KEY (talent_id, skill_id)
+);`
+
+	jobQuestionsTableSchema = `
+CREATE TABLE IF NOT EXISTS job_questions (
+    id SERIAL PRIMARY KEY,
+    job_id INTEGER REFERENCES jobs(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    question TEXT NOT NULL,
+    question_type INTEGER NOT NULL,
+    options TEXT[] NOT NULL DEFAULT '{}',
+    knockout_answers TEXT[] NOT NULL DEFAULT '{}',
+    knockout_min NUMERIC,
+    knockout_max NUMERIC
+);`
+
+	applicationAnswersTableSchema = `
+CREATE TABLE IF NOT EXISTS application_answers (
+    application_id INTEGER REFERENCES applications(id) ON DELETE CASCADE,
+    question_id INTEGER REFERENCES job_questions(id) ON DELETE CASCADE,
+    answer TEXT NOT NULL,
+    knocked_out BOOLEAN NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (application_id, question_id)
+);`
+
+	jobImportsTableSchema = `
+CREATE TABLE IF NOT EXISTS job_imports (
+    id SERIAL PRIMARY KEY,
+    employer_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
+    import_status INTEGER NOT NULL DEFAULT 1,
+    mode VARCHAR(20) NOT NULL,
+    total_rows INTEGER NOT NULL DEFAULT 0,
+    inserted_rows INTEGER NOT NULL DEFAULT 0,
+    failed_rows INTEGER NOT NULL DEFAULT 0,
+    report JSONB NOT NULL DEFAULT '[]',
+    create_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    finish_date TIMESTAMP
+);`
+
+	// Jobs posted before revisions were tracked start from their current
+	// content as revision 1.
+	jobRevisionsTableSchema = `
+CREATE TABLE IF NOT EXISTS job_revisions (
+    id SERIAL PRIMARY KEY,
+    job_id INTEGER REFERENCES jobs(id) ON DELETE CASCADE,
+    revision INTEGER NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL,
+    requirement TEXT NOT NULL,
+    skills TEXT[] NOT NULL DEFAULT '{}',
+    expire_date TIMESTAMP,
+    create_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (job_id, revision)
+);
+
+INSERT INTO job_revisions (job_id, revision, title, description, requirement, skills, expire_date, create_date)
+SELECT j.id, 1, j.title, j.description, j.requirement, ARRAY(SELECT s.name FROM job_skills js JOIN skills s ON s.id = js.skill_id WHERE js.job_id = j.id ORDER BY s.name), j.expire_date, j.create_date
+FROM jobs j;`
+
+	jobModerationsTableSchema = `
+CREATE TABLE IF NOT EXISTS job_moderations (
+    id SERIAL PRIMARY KEY,
+    job_id INTEGER REFERENCES jobs(id) ON DELETE CASCADE,
+    flags TEXT[] NOT NULL DEFAULT '{}',
+    create_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    review_date TIMESTAMP,
+    reviewer_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    decision INTEGER,
+    reason TEXT
+);`
+
+	jobTranslationsTableSchema = `
+CREATE TABLE IF NOT EXISTS job_translations (
+    job_id INTEGER REFERENCES jobs(id) ON DELETE CASCADE,
+    language VARCHAR(35) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL,
+    requirement TEXT NOT NULL,
+    PRIMARY KEY (job_id, language)
+);`
+
+	jobReportsTableSchema = `
+CREATE TABLE IF NOT EXISTS job_reports (
+    id SERIAL PRIMARY KEY,
+    job_id INTEGER REFERENCES jobs(id) ON DELETE CASCADE,
+    talent_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
+    category INTEGER NOT NULL,
+    details TEXT NOT NULL DEFAULT '',
+    report_status INTEGER NOT NULL DEFAULT 1,
+    create_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    review_date TIMESTAMP,
+    reviewer_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    resolution TEXT,
     UNIQUE (job_id, talent_id)
 );`
+
+	applicationStatusHistoryTableSchema = `
+CREATE TABLE IF NOT EXISTS application_status_history (
+    id SERIAL PRIMARY KEY,
+    application_id INTEGER REFERENCES applications(id) ON DELETE CASCADE,
+    from_status INTEGER,
+    to_status INTEGER NOT NULL,
+    actor_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    note TEXT NOT NULL DEFAULT '',
+    change_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);`
+
+	attachmentsTableSchema = `
+CREATE TABLE IF NOT EXISTS attachments (
+    id SERIAL PRIMARY KEY,
+    talent_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
+    kind INTEGER NOT NULL,
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(255) NOT NULL,
+    size BIGINT NOT NULL,
+    storage_key VARCHAR(255) UNIQUE NOT NULL,
+    create_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);`
+
+	applicationAttachmentsTableSchema = `
+CREATE TABLE IF NOT EXISTS application_attachments (
+    application_id INTEGER REFERENCES applications(id) ON DELETE CASCADE,
+    attachment_id INTEGER REFERENCES attachments(id) ON DELETE CASCADE,
+    PRIMARY KEY (application_id, attachment_id)
+);`
+
+	talentProfilesTableSchema = `
+CREATE TABLE IF NOT EXISTS talent_profiles (
+    talent_id INTEGER PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    headline VARCHAR(255) NOT NULL DEFAULT '',
+    summary TEXT NOT NULL DEFAULT '',
+    links TEXT[] NOT NULL DEFAULT '{}',
+    visibility INTEGER NOT NULL DEFAULT 2,
+    update_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);`
+
+	talentExperiencesTableSchema = `
+CREATE TABLE IF NOT EXISTS talent_experiences (
+    id SERIAL PRIMARY KEY,
+    talent_id INTEGER NOT NULL REFERENCES talent_profiles(talent_id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    company VARCHAR(255) NOT NULL,
+    start_date DATE NOT NULL,
+    end_date DATE,
+    description TEXT NOT NULL DEFAULT ''
+);`
+
+	talentEducationsTableSchema = `
+CREATE TABLE IF NOT EXISTS talent_educations (
+    id SERIAL PRIMARY KEY,
+    talent_id INTEGER NOT NULL REFERENCES talent_profiles(talent_id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    school VARCHAR(255) NOT NULL,
+    degree VARCHAR(255) NOT NULL DEFAULT '',
+    field_of_study VARCHAR(255) NOT NULL DEFAULT '',
+    start_date DATE NOT NULL,
+    end_date DATE
+);`
+
+	applicationNotesTableSchema = `
+CREATE TABLE IF NOT EXISTS application_notes (
+    id SERIAL PRIMARY KEY,
+    application_id INTEGER NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+    author_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    body TEXT NOT NULL,
+    create_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);`
+
+	applicationTagsTableSchema = `
+CREATE TABLE IF NOT EXISTS application_tags (
+    application_id INTEGER REFERENCES applications(id) ON DELETE CASCADE,
+    tag VARCHAR(50) NOT NULL,
+    PRIMARY KEY (application_id, tag)
+);`
+
+	scorecardCriteriaTableSchema = `
+CREATE TABLE IF NOT EXISTS scorecard_criteria (
+    id SERIAL PRIMARY KEY,
+    job_id INTEGER NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    weight INTEGER NOT NULL DEFAULT 1,
+    UNIQUE (job_id, name)
+);`
+
+	applicationScoresTableSchema = `
+CREATE TABLE IF NOT EXISTS application_scores (
+    application_id INTEGER REFERENCES applications(id) ON DELETE CASCADE,
+    criterion_id INTEGER REFERENCES scorecard_criteria(id) ON DELETE CASCADE,
+    reviewer_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
+    score INTEGER NOT NULL,
+    update_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (application_id, criterion_id, reviewer_id)
+);`
+
+	interviewSlotsTableSchema = `
+CREATE TABLE IF NOT EXISTS interview_slots (
+    id SERIAL PRIMARY KEY,
+    job_id INTEGER NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+    employer_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    start_date TIMESTAMP NOT NULL,
+    end_date TIMESTAMP NOT NULL,
+    location VARCHAR(255) NOT NULL DEFAULT '',
+    create_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);`
+
+	interviewsTableSchema = `
+CREATE TABLE IF NOT EXISTS interviews (
+    application_id INTEGER PRIMARY KEY REFERENCES applications(id) ON DELETE CASCADE,
+    slot_id INTEGER REFERENCES interview_slots(id) ON DELETE SET NULL,
+    start_date TIMESTAMP NOT NULL,
+    end_date TIMESTAMP NOT NULL,
+    location VARCHAR(255) NOT NULL DEFAULT '',
+    interview_status INTEGER NOT NULL DEFAULT 1,
+    sequence INTEGER NOT NULL DEFAULT 0,
+    update_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);`
 )
+
+// Columns added after a table was first released. They are applied on every
+// start so databases created by an older version catch up with the schema.
+var tableColumns = []struct {
+	table      string
+	column     string
+	definition string
+}{
+	{usersTable, "is_verified", "BOOLEAN NOT NULL DEFAULT FALSE"},
+	{jobsTable, "job_status", "INTEGER NOT NULL DEFAULT 1"},
+	{jobsTable, "expire_date", "TIMESTAMP"},
+	{jobsTable, "openings", "INTEGER"},
+	{jobsTable, "max_applications", "INTEGER"},
+	{jobsTable, "moderation_status", "INTEGER NOT NULL DEFAULT 1"},
+	{jobsTable, "city", "VARCHAR(255)"},
+	{jobsTable, "latitude", "DOUBLE PRECISION"},
+	{jobsTable, "longitude", "DOUBLE PRECISION"},
+	{jobsTable, "language", "VARCHAR(35) NOT NULL DEFAULT 'en'"},
+	{jobsTable, "required_fields", "TEXT[] NOT NULL DEFAULT '{}'"},
+	{jobsTable, "auto_closed", "BOOLEAN NOT NULL DEFAULT FALSE"},
+	{applicationsTable, "job_revision_id", "INTEGER REFERENCES job_revisions(id)"},
+	{applicationsTable, "cover_letter", "TEXT NOT NULL DEFAULT ''"},
+	{applicationsTable, "expected_salary", "BIGINT"},
+	{applicationsTable, "available_from", "DATE"},
+	{applicationsTable, "links", "TEXT[] NOT NULL DEFAULT '{}'"},
+	{applicationsTable, "profile_snapshot", "JSONB"},
+	{jobModerationsTable, "reviewer_id", "INTEGER REFERENCES users(id) ON DELETE SET NULL"},
+	{jobModerationsTable, "decision", "INTEGER"},
+	{jobModerationsTable, "reason", "TEXT"},
+}
+
+// Statements run on every start after the columns are added, for schema
+// changes ADD COLUMN can't express. Each of them must be safe to repeat.
+var migrationStatements = []string{
+	// A talent keeps one active application per job, but can apply again
+	// after withdrawing.
+	"ALTER TABLE applications DROP CONSTRAINT IF EXISTS applications_job_id_talent_id_key",
+	"CREATE UNIQUE INDEX IF NOT EXISTS applications_active_job_talent_key ON applications (job_id, talent_id) WHERE application_status <> 5",
+	// A slot can only be booked once; cancelled interviews keep their slot
+	// so their invite can still be sent.
+	"CREATE UNIQUE INDEX IF NOT EXISTS interviews_booked_slot_key ON interviews (slot_id) WHERE interview_status = 1",
+}
@@ -0,0 +1,332 @@
+package feed
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/michaelwongycn/job-portal/domain/model"
+	"github.com/michaelwongycn/job-portal/lib/markdown"
+)
+
+const (
+	RSSFormat    = "rss"
+	AtomFormat   = "atom"
+	JSONLDFormat = "jsonld"
+	XMLFormat    = "xml"
+)
+
+var contentTypes = map[string]string{
+	RSSFormat:    "application/rss+xml; charset=utf-8",
+	AtomFormat:   "application/atom+xml; charset=utf-8",
+	JSONLDFormat: "application/ld+json; charset=utf-8",
+	XMLFormat:    "application/xml; charset=utf-8",
+}
+
+// Channel describes the site publishing the feed. Job links are built by
+// appending "/job/{id}" to Link.
+type Channel struct {
+	Title       string
+	Link        string
+	Description string
+}
+
+// ContentType returns the media type of the format, or an empty string when
+// the format is unknown.
+func ContentType(format string) string {
+	return contentTypes[format]
+}
+
+// Render encodes the jobs in the given format. The output only depends on the
+// channel and the jobs, so it can be hashed into a stable ETag.
+func Render(format string, channel Channel, jobs []model.Job) ([]byte, error) {
+	switch format {
+	case RSSFormat:
+		return RSS(channel, jobs)
+	case AtomFormat:
+		return Atom(channel, jobs)
+	case JSONLDFormat:
+		return JSONLD(channel, jobs)
+	case XMLFormat:
+		return AggregatorXML(channel, jobs)
+	default:
+		return nil, fmt.Errorf("unknown feed format %s", format)
+	}
+}
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	GUID        rssGUID  `xml:"guid"`
+	PubDate     string   `xml:"pubDate"`
+	Description string   `xml:"description"`
+	Categories  []string `xml:"category"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// RSS encodes the jobs as an RSS 2.0 feed.
+func RSS(channel Channel, jobs []model.Job) ([]byte, error) {
+	feed := rssFeed{
+		Version: "2.0",
+		Channel: rssChannel{
+			Title:       channel.Title,
+			Link:        channel.Link,
+			Description: channel.Description,
+			Items:       []rssItem{},
+		},
+	}
+	if updated, ok := lastUpdated(jobs); ok {
+		feed.Channel.LastBuildDate = updated.Format(time.RFC1123Z)
+	}
+
+	for _, job := range jobs {
+		link := jobLink(channel, job)
+		feed.Channel.Items = append(feed.Channel.Items, rssItem{
+			Title:       job.Title,
+			Link:        link,
+			GUID:        rssGUID{IsPermaLink: true, Value: link},
+			PubDate:     job.CreateDate.UTC().Format(time.RFC1123Z),
+			Description: jobSummary(job),
+			Categories:  job.Skills,
+		})
+	}
+	return encodeXML(feed)
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Title   string      `xml:"title"`
+	ID      string      `xml:"id"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Author  atomAuthor  `xml:"author"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomEntry struct {
+	Title      string         `xml:"title"`
+	ID         string         `xml:"id"`
+	Link       atomLink       `xml:"link"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Author     *atomAuthor    `xml:"author,omitempty"`
+	Content    atomContent    `xml:"content"`
+	Categories []atomCategory `xml:"category"`
+}
+
+type atomContent struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+// Atom encodes the jobs as an Atom 1.0 feed. Entries are attributed to the
+// hiring company when it has a profile and to the feed publisher otherwise.
+func Atom(channel Channel, jobs []model.Job) ([]byte, error) {
+	updated, _ := lastUpdated(jobs)
+	feed := atomFeed{
+		Title:   channel.Title,
+		ID:      channel.Link + "/",
+		Updated: updated.Format(time.RFC3339),
+		Links:   []atomLink{{Href: channel.Link + "/"}},
+		Author:  atomAuthor{Name: channel.Title},
+		Entries: []atomEntry{},
+	}
+
+	for _, job := range jobs {
+		link := jobLink(channel, job)
+		entry := atomEntry{
+			Title:     job.Title,
+			ID:        link,
+			Link:      atomLink{Href: link, Rel: "alternate"},
+			Published: job.CreateDate.UTC().Format(time.RFC3339),
+			Updated:   job.CreateDate.UTC().Format(time.RFC3339),
+			Content:   atomContent{Type: "html", Value: jobSummary(job)},
+		}
+		if job.Company != nil {
+			entry.Author = &atomAuthor{Name: job.Company.Name}
+		}
+		for _, skill := range job.Skills {
+			entry.Categories = append(entry.Categories, atomCategory{Term: skill})
+		}
+		feed.Entries = append(feed.Entries, entry)
+	}
+	return encodeXML(feed)
+}
+
+type jobPosting struct {
+	Type               string        `json:"@type"`
+	Title              string        `json:"title"`
+	Description        string        `json:"description"`
+	DatePosted         string        `json:"datePosted"`
+	ValidThrough       string        `json:"validThrough,omitempty"`
+	URL                string        `json:"url"`
+	Identifier         propertyValue `json:"identifier"`
+	HiringOrganization *organization `json:"hiringOrganization,omitempty"`
+	Skills             string        `json:"skills,omitempty"`
+	Qualifications     string        `json:"qualifications,omitempty"`
+}
+
+type propertyValue struct {
+	Type  string `json:"@type"`
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type organization struct {
+	Type string `json:"@type"`
+	Name string `json:"name"`
+	Logo string `json:"logo,omitempty"`
+}
+
+// JSONLD encodes the jobs as a schema.org graph of JobPosting objects.
+func JSONLD(channel Channel, jobs []model.Job) ([]byte, error) {
+	postings := []jobPosting{}
+	for _, job := range jobs {
+		posting := jobPosting{
+			Type:           "JobPosting",
+			Title:          job.Title,
+			Description:    markdown.ToHTML(job.Description),
+			DatePosted:     job.CreateDate.UTC().Format(time.RFC3339),
+			URL:            jobLink(channel, job),
+			Identifier:     propertyValue{Type: "PropertyValue", Name: channel.Title, Value: strconv.Itoa(job.ID)},
+			Skills:         strings.Join(job.Skills, ", "),
+			Qualifications: markdown.ToHTML(job.Requirement),
+		}
+		if job.ExpireDate != nil {
+			posting.ValidThrough = job.ExpireDate.UTC().Format(time.RFC3339)
+		}
+		if job.Company != nil {
+			posting.HiringOrganization = &organization{Type: "Organization", Name: job.Company.Name, Logo: job.Company.LogoPath}
+		}
+		postings = append(postings, posting)
+	}
+
+	return json.Marshal(struct {
+		Context string       `json:"@context"`
+		Graph   []jobPosting `json:"@graph"`
+	}{
+		Context: "https://schema.org",
+		Graph:   postings,
+	})
+}
+
+type aggregatorSource struct {
+	XMLName       xml.Name        `xml:"source"`
+	Publisher     string          `xml:"publisher"`
+	PublisherURL  string          `xml:"publisherurl"`
+	LastBuildDate string          `xml:"lastBuildDate,omitempty"`
+	Jobs          []aggregatorJob `xml:"job"`
+}
+
+type aggregatorJob struct {
+	Title           cdata  `xml:"title"`
+	Date            cdata  `xml:"date"`
+	ReferenceNumber cdata  `xml:"referencenumber"`
+	URL             cdata  `xml:"url"`
+	Company         cdata  `xml:"company"`
+	Description     cdata  `xml:"description"`
+	Requirements    cdata  `xml:"requirements"`
+	Category        cdata  `xml:"category"`
+	ExpirationDate  *cdata `xml:"expirationdate,omitempty"`
+}
+
+type cdata struct {
+	Value string `xml:",cdata"`
+}
+
+// AggregatorXML encodes the jobs in the <source>/<job> XML layout most job
+// aggregators crawl, with every value wrapped in CDATA.
+func AggregatorXML(channel Channel, jobs []model.Job) ([]byte, error) {
+	source := aggregatorSource{
+		Publisher:    channel.Title,
+		PublisherURL: channel.Link,
+		Jobs:         []aggregatorJob{},
+	}
+	if updated, ok := lastUpdated(jobs); ok {
+		source.LastBuildDate = updated.Format(time.RFC1123Z)
+	}
+
+	for _, job := range jobs {
+		aggregatorJob := aggregatorJob{
+			Title:           cdata{job.Title},
+			Date:            cdata{job.CreateDate.UTC().Format(time.RFC1123Z)},
+			ReferenceNumber: cdata{strconv.Itoa(job.ID)},
+			URL:             cdata{jobLink(channel, job)},
+			Description:     cdata{markdown.ToHTML(job.Description)},
+			Requirements:    cdata{markdown.ToHTML(job.Requirement)},
+			Category:        cdata{strings.Join(job.Skills, ", ")},
+		}
+		if job.Company != nil {
+			aggregatorJob.Company = cdata{job.Company.Name}
+		}
+		if job.ExpireDate != nil {
+			aggregatorJob.ExpirationDate = &cdata{job.ExpireDate.UTC().Format(time.RFC1123Z)}
+		}
+		source.Jobs = append(source.Jobs, aggregatorJob)
+	}
+	return encodeXML(source)
+}
+
+func encodeXML(v interface{}) ([]byte, error) {
+	data, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), data...), nil
+}
+
+func jobLink(channel Channel, job model.Job) string {
+	return channel.Link + "/job/" + strconv.Itoa(job.ID)
+}
+
+// jobSummary renders the description followed by the requirement as HTML.
+// They are rendered apart so an unclosed block in one can't swallow the other.
+func jobSummary(job model.Job) string {
+	return markdown.ToHTML(job.Description) + markdown.ToHTML(job.Requirement)
+}
+
+// lastUpdated returns the newest creation date among the jobs, or the Unix
+// epoch when there are none, so empty feeds stay stable too.
+func lastUpdated(jobs []model.Job) (time.Time, bool) {
+	updated := time.Unix(0, 0).UTC()
+	for _, job := range jobs {
+		if job.CreateDate.After(updated) {
+			updated = job.CreateDate.UTC()
+		}
+	}
+	return updated, len(jobs) > 0
+}
@@ -0,0 +1,138 @@
+package geo
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const earthRadiusKm = 6371.0
+
+// cities.csv is a small offline gazetteer of the cities jobs are posted in,
+// so geocoding never depends on an external service.
+//
+//go:embed cities.csv
+var citiesCSV string
+
+type Point struct {
+	Latitude  float64
+	Longitude float64
+}
+
+type City struct {
+	Name    string
+	Country string
+	Point
+}
+
+// BoundingBox is the latitude and longitude range around a point. When the
+// box crosses the antimeridian MinLongitude is greater than MaxLongitude.
+type BoundingBox struct {
+	MinLatitude  float64
+	MaxLatitude  float64
+	MinLongitude float64
+	MaxLongitude float64
+}
+
+var cities = loadCities()
+
+func loadCities() map[string]City {
+	records, err := csv.NewReader(strings.NewReader(citiesCSV)).ReadAll()
+	if err != nil {
+		panic(err)
+	}
+
+	cities := make(map[string]City)
+	for _, record := range records[1:] {
+		latitude, err := strconv.ParseFloat(record[2], 64)
+		if err != nil {
+			panic(err)
+		}
+		longitude, err := strconv.ParseFloat(record[3], 64)
+		if err != nil {
+			panic(err)
+		}
+
+		city := City{Name: record[0], Country: record[1], Point: Point{Latitude: latitude, Longitude: longitude}}
+		cities[cityKey(city.Name+", "+city.Country)] = city
+		if _, ok := cities[cityKey(city.Name)]; !ok {
+			cities[cityKey(city.Name)] = city
+		}
+	}
+	return cities
+}
+
+func cityKey(name string) string {
+	return strings.Join(strings.Fields(strings.ToLower(name)), " ")
+}
+
+// Lookup finds a city by name, optionally followed by a comma and its ISO
+// country code, such as "Jakarta" or "Jakarta, ID". Matching ignores case and
+// extra spaces.
+func Lookup(name string) (City, bool) {
+	parts := strings.Split(name, ",")
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+	}
+	city, ok := cities[cityKey(strings.Join(parts, ", "))]
+	return city, ok
+}
+
+// Valid reports whether the point lies within the latitude and longitude
+// ranges.
+func Valid(p Point) bool {
+	return p.Latitude >= -90 && p.Latitude <= 90 && p.Longitude >= -180 && p.Longitude <= 180
+}
+
+// Distance returns the great circle distance between two points in
+// kilometres, using the haversine formula.
+func Distance(a, b Point) float64 {
+	lat1 := a.Latitude * math.Pi / 180
+	lat2 := b.Latitude * math.Pi / 180
+	dLat := lat2 - lat1
+	dLon := (b.Longitude - a.Longitude) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// Box returns a bounding box containing every point within radiusKm of the
+// center. It is meant as a cheap prefilter, the exact distance still has to
+// be checked with Distance.
+func Box(center Point, radiusKm float64) BoundingBox {
+	delta := radiusKm / earthRadiusKm * 180 / math.Pi
+	box := BoundingBox{
+		MinLatitude:  center.Latitude - delta,
+		MaxLatitude:  center.Latitude + delta,
+		MinLongitude: -180,
+		MaxLongitude: 180,
+	}
+
+	// Near the poles every longitude can be within reach.
+	if box.MinLatitude <= -90 || box.MaxLatitude >= 90 {
+		box.MinLatitude = math.Max(box.MinLatitude, -90)
+		box.MaxLatitude = math.Min(box.MaxLatitude, 90)
+		return box
+	}
+
+	ratio := math.Sin(radiusKm/earthRadiusKm) / math.Cos(center.Latitude*math.Pi/180)
+	if ratio >= 1 {
+		return box
+	}
+	lonDelta := math.Asin(ratio) * 180 / math.Pi
+	box.MinLongitude = wrapLongitude(center.Longitude - lonDelta)
+	box.MaxLongitude = wrapLongitude(center.Longitude + lonDelta)
+	return box
+}
+
+func wrapLongitude(longitude float64) float64 {
+	if longitude < -180 {
+		return longitude + 360
+	}
+	if longitude > 180 {
+		return longitude - 360
+	}
+	return longitude
+}
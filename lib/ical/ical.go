@@ -0,0 +1,121 @@
+package ical
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	RequestMethod = "REQUEST"
+	CancelMethod  = "CANCEL"
+
+	ContentType = "text/calendar; charset=utf-8"
+
+	productId     = "-//Job Portal//Interviews//EN"
+	dateFormat    = "20060102T150405Z"
+	maxLineLength = 75
+)
+
+// Event is a single meeting sent as an RFC 5545 invitation. Calendars match
+// updates to the event they already have by UID, and only apply them when
+// Sequence is higher than the last one seen, so every change to an event has
+// to keep its UID and raise its Sequence.
+type Event struct {
+	UID         string
+	Sequence    int
+	Stamp       time.Time
+	Start       time.Time
+	End         time.Time
+	Summary     string
+	Description string
+	Location    string
+	Organizer   string
+	Attendees   []string
+	Cancelled   bool
+}
+
+// Method is the iTIP method the event is sent with: REQUEST for new and
+// updated events and CANCEL for cancelled ones.
+func (e Event) Method() string {
+	if e.Cancelled {
+		return CancelMethod
+	}
+	return RequestMethod
+}
+
+// Encode writes the event as a calendar object, ready to be downloaded as an
+// .ics file or attached to an email.
+func Encode(event Event) []byte {
+	var buf bytes.Buffer
+	status := "CONFIRMED"
+	if event.Cancelled {
+		status = "CANCELLED"
+	}
+
+	writeLine(&buf, "BEGIN:VCALENDAR")
+	writeLine(&buf, "VERSION:2.0")
+	writeLine(&buf, "PRODID:"+productId)
+	writeLine(&buf, "CALSCALE:GREGORIAN")
+	writeLine(&buf, "METHOD:"+event.Method())
+	writeLine(&buf, "BEGIN:VEVENT")
+	writeLine(&buf, "UID:"+escapeText(event.UID))
+	writeLine(&buf, "SEQUENCE:"+strconv.Itoa(event.Sequence))
+	writeLine(&buf, "DTSTAMP:"+formatDate(event.Stamp))
+	writeLine(&buf, "DTSTART:"+formatDate(event.Start))
+	writeLine(&buf, "DTEND:"+formatDate(event.End))
+	writeLine(&buf, "SUMMARY:"+escapeText(event.Summary))
+	if event.Description != "" {
+		writeLine(&buf, "DESCRIPTION:"+escapeText(event.Description))
+	}
+	if event.Location != "" {
+		writeLine(&buf, "LOCATION:"+escapeText(event.Location))
+	}
+	if event.Organizer != "" {
+		writeLine(&buf, "ORGANIZER:mailto:"+event.Organizer)
+	}
+	for _, attendee := range event.Attendees {
+		writeLine(&buf, "ATTENDEE;ROLE=REQ-PARTICIPANT;RSVP=TRUE:mailto:"+attendee)
+	}
+	writeLine(&buf, "STATUS:"+status)
+	writeLine(&buf, "END:VEVENT")
+	writeLine(&buf, "END:VCALENDAR")
+	return buf.Bytes()
+}
+
+func formatDate(date time.Time) string {
+	return date.UTC().Format(dateFormat)
+}
+
+// escapeText escapes the characters RFC 5545 reserves in TEXT values.
+// Carriage returns are dropped, so CRLF and LF both end up as one line break.
+func escapeText(value string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r", "",
+		"\n", `\n`,
+	).Replace(value)
+}
+
+// writeLine folds the content line into lines of at most 75 octets, each
+// continuation starting with a space, without splitting a UTF-8 character.
+func writeLine(buf *bytes.Buffer, line string) {
+	limit := maxLineLength
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		buf.WriteString(line[:cut])
+		buf.WriteString("\r\n ")
+		line = line[cut:]
+		// The leading space counts towards the length of the next line.
+		limit = maxLineLength - 1
+	}
+	buf.WriteString(line)
+	buf.WriteString("\r\n")
+}
@@ -0,0 +1,125 @@
+package locale
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Normalize returns the canonical form of a BCP 47 language tag, with a
+// lower-case language, title-case script and upper-case region such as
+// "zh-Hant-TW". It returns an empty string when the tag is malformed.
+func Normalize(tag string) string {
+	subtags := strings.FieldsFunc(strings.TrimSpace(tag), func(r rune) bool {
+		return r == '-' || r == '_'
+	})
+	if len(subtags) == 0 || len(subtags[0]) < 2 || len(subtags[0]) > 3 || !isAlpha(subtags[0]) {
+		return ""
+	}
+
+	subtags[0] = strings.ToLower(subtags[0])
+	for i, subtag := range subtags[1:] {
+		switch {
+		case len(subtag) == 2 && isAlpha(subtag):
+			subtags[i+1] = strings.ToUpper(subtag)
+		case len(subtag) == 4 && isAlpha(subtag):
+			subtags[i+1] = strings.ToUpper(subtag[:1]) + strings.ToLower(subtag[1:])
+		case len(subtag) >= 3 && len(subtag) <= 8 && isAlphanumeric(subtag):
+			subtags[i+1] = strings.ToLower(subtag)
+		default:
+			return ""
+		}
+	}
+	return strings.Join(subtags, "-")
+}
+
+// Base returns the language subtag of a tag, "en" for "en-SG".
+func Base(tag string) string {
+	base, _, _ := strings.Cut(tag, "-")
+	return strings.ToLower(base)
+}
+
+// ParseAcceptLanguage returns the tags of an Accept-Language header ordered
+// from most to least preferred. Tags with a zero quality, the "*" wildcard and
+// malformed tags are dropped.
+func ParseAcceptLanguage(header string) []string {
+	type weightedTag struct {
+		tag     string
+		quality float64
+	}
+
+	weighted := []weightedTag{}
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		quality := 1.0
+		for _, param := range strings.Split(params, ";") {
+			name, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+			if !ok || strings.TrimSpace(name) != "q" {
+				continue
+			}
+			q, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil || q < 0 || q > 1 {
+				q = 0
+			}
+			quality = q
+		}
+
+		tag = Normalize(tag)
+		if tag == "" || quality == 0 {
+			continue
+		}
+		weighted = append(weighted, weightedTag{tag: tag, quality: quality})
+	}
+
+	sort.SliceStable(weighted, func(i, j int) bool {
+		return weighted[i].quality > weighted[j].quality
+	})
+
+	tags := []string{}
+	for _, w := range weighted {
+		tags = append(tags, w.tag)
+	}
+	return tags
+}
+
+// Match picks the available language that best serves the preferences. Each
+// preference is tried in order, first for an exact match and then for any
+// available tag of the same base language, so "en-SG" is served by "en" and
+// "id" by "id-ID". The fallback is returned when nothing matches.
+func Match(preferences, available []string, fallback string) string {
+	for _, preference := range preferences {
+		preference = Normalize(preference)
+		if preference == "" {
+			continue
+		}
+		for _, tag := range available {
+			if tag == preference {
+				return tag
+			}
+		}
+		for _, tag := range available {
+			if Base(tag) == Base(preference) {
+				return tag
+			}
+		}
+	}
+	return fallback
+}
+
+func isAlpha(s string) bool {
+	for _, r := range s {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+func isAlphanumeric(s string) bool {
+	for _, r := range s {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,324 @@
+package markdown
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"html"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jellydator/ttlcache/v3"
+)
+
+// Rendered HTML is cached by the hash of its source, so the same description
+// is only rendered once no matter how often the job is read.
+var cache = ttlcache.New(
+	ttlcache.WithTTL[string, string](time.Hour),
+	ttlcache.WithCapacity[string, string](10000),
+)
+
+// Link text and hrefs longer than these are rendered as plain text. Bounding
+// them keeps each bracket's scan short, so rendering stays linear.
+const (
+	maxLinkTextLength = 1000
+	maxHrefLength     = 2048
+)
+
+// allowedSchemes lists the link schemes kept in the output. Links using any
+// other scheme, such as javascript:, are rendered as plain text.
+var allowedSchemes = map[string]bool{
+	"http":   true,
+	"https":  true,
+	"mailto": true,
+}
+
+var (
+	headingPattern     = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+	ruleLinePattern    = regexp.MustCompile(`^ {0,3}((\*\s*){3,}|(-\s*){3,}|(_\s*){3,})$`)
+	bulletPattern      = regexp.MustCompile(`^ {0,3}[-*+]\s+(.*)$`)
+	orderedPattern     = regexp.MustCompile(`^ {0,3}(\d{1,9})[.)]\s+(.*)$`)
+	fencePattern       = regexp.MustCompile("^ {0,3}(```|~~~)")
+	blockquotePattern  = regexp.MustCompile(`^ {0,3}> ?(.*)$`)
+	continuationIndent = regexp.MustCompile(`^( {2,}|\t)\S`)
+)
+
+// ToHTML renders Markdown source as HTML. Raw HTML in the source is escaped
+// rather than passed through, so the output only ever holds the elements the
+// renderer emits itself: p, h1-h6, ul, ol, li, blockquote, pre, code, hr, em,
+// strong, br and a with an allowed scheme.
+func ToHTML(source string) string {
+	if strings.TrimSpace(source) == "" {
+		return ""
+	}
+
+	sum := sha256.Sum256([]byte(source))
+	key := hex.EncodeToString(sum[:])
+	if item := cache.Get(key); item != nil {
+		return item.Value()
+	}
+
+	rendered := renderBlocks(strings.Split(strings.ReplaceAll(source, "\r\n", "\n"), "\n"))
+	cache.Set(key, rendered, ttlcache.DefaultTTL)
+	return rendered
+}
+
+func renderBlocks(lines []string) string {
+	var b strings.Builder
+	paragraph := []string{}
+	flush := func() {
+		if len(paragraph) > 0 {
+			b.WriteString("<p>" + renderInline(strings.Join(paragraph, "\n")) + "</p>\n")
+			paragraph = paragraph[:0]
+		}
+	}
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+
+		if strings.TrimSpace(line) == "" {
+			flush()
+			continue
+		}
+
+		if fence := fencePattern.FindStringSubmatch(line); fence != nil {
+			flush()
+			code := []string{}
+			for i++; i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), fence[1]); i++ {
+				code = append(code, lines[i])
+			}
+			b.WriteString("<pre><code>" + html.EscapeString(strings.Join(code, "\n")) + "</code></pre>\n")
+			continue
+		}
+
+		if heading := headingPattern.FindStringSubmatch(line); heading != nil {
+			flush()
+			level := strconv.Itoa(len(heading[1]))
+			b.WriteString("<h" + level + ">" + renderInline(heading[2]) + "</h" + level + ">\n")
+			continue
+		}
+
+		if ruleLinePattern.MatchString(line) {
+			flush()
+			b.WriteString("<hr>\n")
+			continue
+		}
+
+		if blockquotePattern.MatchString(line) {
+			flush()
+			quote := []string{}
+			for ; i < len(lines); i++ {
+				match := blockquotePattern.FindStringSubmatch(lines[i])
+				if match == nil {
+					break
+				}
+				quote = append(quote, match[1])
+			}
+			i--
+			b.WriteString("<blockquote>\n" + renderBlocks(quote) + "</blockquote>\n")
+			continue
+		}
+
+		if bulletPattern.MatchString(line) || orderedPattern.MatchString(line) {
+			flush()
+			var consumed int
+			var list string
+			if bulletPattern.MatchString(line) {
+				list, consumed = renderList(lines[i:], bulletPattern, "ul")
+			} else {
+				list, consumed = renderList(lines[i:], orderedPattern, "ol")
+			}
+			b.WriteString(list)
+			i += consumed - 1
+			continue
+		}
+
+		paragraph = append(paragraph, strings.TrimLeft(line, " \t"))
+	}
+	flush()
+	return b.String()
+}
+
+// renderList renders the list starting at lines[0] and returns it with the
+// number of lines it took. Indented lines continue the previous item.
+func renderList(lines []string, itemPattern *regexp.Regexp, tag string) (string, int) {
+	items := [][]string{}
+	start := ""
+	i := 0
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		if match := itemPattern.FindStringSubmatch(line); match != nil {
+			if len(items) == 0 && tag == "ol" && match[1] != "1" {
+				start = match[1]
+			}
+			items = append(items, []string{match[len(match)-1]})
+			continue
+		}
+		if continuationIndent.MatchString(line) {
+			items[len(items)-1] = append(items[len(items)-1], strings.TrimSpace(line))
+			continue
+		}
+		break
+	}
+
+	var b strings.Builder
+	if start != "" {
+		n, _ := strconv.Atoi(start)
+		b.WriteString("<" + tag + " start=\"" + strconv.Itoa(n) + "\">\n")
+	} else {
+		b.WriteString("<" + tag + ">\n")
+	}
+	for _, item := range items {
+		b.WriteString("<li>" + renderInline(strings.Join(item, "\n")) + "</li>\n")
+	}
+	b.WriteString("</" + tag + ">\n")
+	return b.String(), i
+}
+
+func renderInline(s string) string {
+	var b strings.Builder
+	// A link needs a "](" after its opening bracket, so brackets past the last
+	// one are plain text and parseLink never scans beyond it.
+	lastLink := strings.LastIndex(s, "](")
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == '\\' && i+1 < len(s) && isPunctuation(s[i+1]):
+			b.WriteString(html.EscapeString(s[i+1 : i+2]))
+			i += 2
+			continue
+
+		case c == '\\' && i+1 < len(s) && s[i+1] == '\n':
+			b.WriteString("<br>\n")
+			i += 2
+			continue
+
+		case c == '`':
+			run := countRun(s[i:], '`')
+			delimiter := s[i : i+run]
+			if end := strings.Index(s[i+run:], delimiter); end >= 0 {
+				code := strings.TrimSpace(s[i+run : i+run+end])
+				b.WriteString("<code>" + html.EscapeString(code) + "</code>")
+				i += run + end + run
+				continue
+			}
+			b.WriteString(delimiter)
+			i += run
+			continue
+
+		case c == '*' || c == '_':
+			if c == '_' && i > 0 && isWordByte(s[i-1]) {
+				break
+			}
+			run := countRun(s[i:], c)
+			if run > 2 {
+				run = 2
+			}
+			delimiter := s[i : i+run]
+			end := strings.Index(s[i+run:], delimiter)
+			if end > 0 && s[i+run] != ' ' && s[i+run+end-1] != ' ' {
+				tag := "em"
+				if run == 2 {
+					tag = "strong"
+				}
+				b.WriteString("<" + tag + ">" + renderInline(s[i+run:i+run+end]) + "</" + tag + ">")
+				i += run + end + run
+				continue
+			}
+
+		case c == '[' && i < lastLink:
+			if text, href, n, ok := parseLink(s[i:], lastLink-i); ok {
+				if safe, ok := safeURL(href); ok {
+					b.WriteString(`<a href="` + html.EscapeString(safe) + `" rel="nofollow noopener noreferrer">` + renderInline(text) + "</a>")
+				} else {
+					b.WriteString(renderInline(text))
+				}
+				i += n
+				continue
+			}
+
+		case c == ' ':
+			// Two or more spaces before a newline make a hard break.
+			run := countRun(s[i:], ' ')
+			if run >= 2 && i+run < len(s) && s[i+run] == '\n' {
+				b.WriteString("<br>\n")
+				i += run + 1
+				continue
+			}
+			b.WriteString(s[i : i+run])
+			i += run
+			continue
+		}
+
+		b.WriteString(html.EscapeString(s[i : i+1]))
+		i++
+	}
+	return b.String()
+}
+
+// parseLink parses "[text](href)" at the start of s and returns its parts
+// with the number of bytes it spans. The closing bracket is only looked for
+// up to limit, the offset of the last "](" in s.
+func parseLink(s string, limit int) (string, string, int, bool) {
+	limit = min(limit, maxLinkTextLength)
+	depth := 0
+	closing := -1
+	for i := 0; i <= limit && closing < 0; i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				closing = i
+			}
+		}
+	}
+	if closing < 0 || closing+1 >= len(s) || s[closing+1] != '(' {
+		return "", "", 0, false
+	}
+
+	// An href can't hold spaces or newlines, so stop at the first of them.
+	rest := s[closing+2 : min(len(s), closing+2+maxHrefLength+1)]
+	end := strings.IndexAny(rest, ") \n")
+	if end < 0 || rest[end] != ')' {
+		return "", "", 0, false
+	}
+	href := s[closing+2 : closing+2+end]
+	return s[1:closing], href, closing + 2 + end + 1, true
+}
+
+// safeURL returns href when it is an absolute URL using an allowed scheme.
+func safeURL(href string) (string, bool) {
+	for _, r := range href {
+		if r < 0x20 || r == 0x7f {
+			return "", false
+		}
+	}
+
+	u, err := url.Parse(href)
+	if err != nil || !allowedSchemes[strings.ToLower(u.Scheme)] {
+		return "", false
+	}
+	return u.String(), true
+}
+
+func countRun(s string, c byte) int {
+	n := 0
+	for n < len(s) && s[n] == c {
+		n++
+	}
+	return n
+}
+
+func isWordByte(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+func isPunctuation(c byte) bool {
+	return strings.IndexByte("!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~", c) >= 0
+}
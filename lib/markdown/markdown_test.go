@@ -0,0 +1,156 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestToHTML(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   string
+	}{
+		{
+			name:   "empty",
+			source: "  \n ",
+			want:   "",
+		},
+		{
+			name:   "script tag is escaped",
+			source: "<script>alert(1)</script>",
+			want:   "<p>&lt;script&gt;alert(1)&lt;/script&gt;</p>\n",
+		},
+		{
+			name:   "script tag in code block is escaped",
+			source: "```\n<script>alert(1)</script>\n```",
+			want:   "<pre><code>&lt;script&gt;alert(1)&lt;/script&gt;</code></pre>\n",
+		},
+		{
+			name:   "http link",
+			source: "[site](https://example.com/a?b=1&c=2)",
+			want:   `<p><a href="https://example.com/a?b=1&amp;c=2" rel="nofollow noopener noreferrer">site</a></p>` + "\n",
+		},
+		{
+			name:   "mailto link",
+			source: "[mail](mailto:jobs@example.com)",
+			want:   `<p><a href="mailto:jobs@example.com" rel="nofollow noopener noreferrer">mail</a></p>` + "\n",
+		},
+		{
+			name:   "javascript link",
+			source: "[click](javascript:alert(1))",
+			want:   "<p>click)</p>\n",
+		},
+		{
+			name:   "javascript link with mixed case",
+			source: "[click](JavaScript:alert`1`)",
+			want:   "<p>click</p>\n",
+		},
+		{
+			name:   "data link",
+			source: "[click](data:text/html;base64,PHNjcmlwdD4=)",
+			want:   "<p>click</p>\n",
+		},
+		{
+			name:   "protocol relative link",
+			source: "[click](//evil.example.com)",
+			want:   "<p>click</p>\n",
+		},
+		{
+			name:   "link text is escaped",
+			source: `[<img src=x onerror=alert(1)>](https://example.com)`,
+			want:   `<p><a href="https://example.com" rel="nofollow noopener noreferrer">&lt;img src=x onerror=alert(1)&gt;</a></p>` + "\n",
+		},
+		{
+			name:   "nested brackets",
+			source: "[a [b] c](https://example.com)",
+			want:   `<p><a href="https://example.com" rel="nofollow noopener noreferrer">a [b] c</a></p>` + "\n",
+		},
+		{
+			name:   "unclosed brackets",
+			source: "[[a] b",
+			want:   "<p>[[a] b</p>\n",
+		},
+		{
+			name:   "bracket without href",
+			source: "[a] (https://example.com)",
+			want:   "<p>[a] (https://example.com)</p>\n",
+		},
+		{
+			name:   "href with a space",
+			source: "[a](https://example.com b)",
+			want:   "<p>[a](https://example.com b)</p>\n",
+		},
+		{
+			name:   "emphasis and strong",
+			source: "*em* _em_ **strong** __strong__",
+			want:   "<p><em>em</em> <em>em</em> <strong>strong</strong> <strong>strong</strong></p>\n",
+		},
+		{
+			name:   "emphasis needs text next to its delimiters",
+			source: "a * not em * and ** not strong **",
+			want:   "<p>a * not em * and ** not strong **</p>\n",
+		},
+		{
+			name:   "unclosed emphasis",
+			source: "*open and **open",
+			want:   "<p>*open and **open</p>\n",
+		},
+		{
+			name:   "underscore inside a word",
+			source: "snake_case_name",
+			want:   "<p>snake_case_name</p>\n",
+		},
+		{
+			name:   "escaped emphasis",
+			source: `\*not em\*`,
+			want:   "<p>*not em*</p>\n",
+		},
+		{
+			name:   "nested emphasis",
+			source: "**bold _and em_**",
+			want:   "<p><strong>bold <em>and em</em></strong></p>\n",
+		},
+		{
+			name:   "hard break",
+			source: "one  \ntwo\nthree",
+			want:   "<p>one<br>\ntwo\nthree</p>\n",
+		},
+		{
+			name:   "inline code",
+			source: "use `<b>` here",
+			want:   "<p>use <code>&lt;b&gt;</code> here</p>\n",
+		},
+		{
+			name:   "heading list and quote",
+			source: "# Title\n\n- one\n- two\n\n> quoted",
+			want:   "<h1>Title</h1>\n<ul>\n<li>one</li>\n<li>two</li>\n</ul>\n<blockquote>\n<p>quoted</p>\n</blockquote>\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ToHTML(tt.source); got != tt.want {
+				t.Errorf("ToHTML(%q) = %q, want %q", tt.source, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToHTMLLinear(t *testing.T) {
+	sources := []string{
+		strings.Repeat("[", 20000),
+		strings.Repeat("[", 20000) + "](https://example.com)",
+		strings.Repeat("[a](", 5000),
+		strings.Repeat(" ", 20000) + "\n",
+		strings.Repeat("a  \n", 5000),
+	}
+	for _, source := range sources {
+		start := time.Now()
+		renderBlocks(strings.Split(source, "\n"))
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Errorf("rendering %d bytes took %s", len(source), elapsed)
+		}
+	}
+}
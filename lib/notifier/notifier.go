@@ -0,0 +1,103 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/michaelwongycn/job-portal/domain/config"
+)
+
+const (
+	FileNotifierType = "file"
+	LogNotifierType  = "log"
+)
+
+type Notification struct {
+	Recipient   string       `json:"recipient"`
+	Subject     string       `json:"subject"`
+	Body        string       `json:"body"`
+	Attachments []Attachment `json:"attachments,omitempty"`
+	CreateDate  time.Time    `json:"create_date"`
+}
+
+// Attachment is a file sent along with a notification, such as a calendar
+// invite.
+type Attachment struct {
+	FileName    string `json:"file_name"`
+	ContentType string `json:"content_type"`
+	Content     []byte `json:"content"`
+}
+
+// Notifier delivers notifications to users. Implementations decide the
+// transport, so an SMTP or push sink can be plugged in without touching the
+// usecases that send notifications.
+type Notifier interface {
+	Notify(ctx context.Context, notification Notification) error
+}
+
+func NewNotifier(cfg config.NotifierConfig) (Notifier, error) {
+	switch cfg.Type {
+	case FileNotifierType:
+		return NewFileNotifier(cfg.OutboxPath), nil
+	case LogNotifierType, "":
+		return NewLogNotifier(), nil
+	default:
+		return nil, fmt.Errorf("unknown notifier type %s", cfg.Type)
+	}
+}
+
+type fileNotifier struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFileNotifier appends every notification as a JSON line to an outbox
+// file, which is handy to inspect what would have been sent during local
+// testing.
+func NewFileNotifier(path string) Notifier {
+	return &fileNotifier{
+		path: path,
+	}
+}
+
+func (n *fileNotifier) Notify(ctx context.Context, notification Notification) error {
+	if notification.CreateDate.IsZero() {
+		notification.CreateDate = time.Now()
+	}
+
+	line, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	file, err := os.OpenFile(n.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = file.Write(append(line, '\n'))
+	return err
+}
+
+type logNotifier struct{}
+
+func NewLogNotifier() Notifier {
+	return &logNotifier{}
+}
+
+func (n *logNotifier) Notify(ctx context.Context, notification Notification) error {
+	log.Printf("Notification to %s: %s\n%s", notification.Recipient, notification.Subject, notification.Body)
+	for _, attachment := range notification.Attachments {
+		log.Printf("Attachment %s (%s, %d bytes)", attachment.FileName, attachment.ContentType, len(attachment.Content))
+	}
+	return nil
+}
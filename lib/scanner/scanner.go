@@ -0,0 +1,132 @@
+package scanner
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/michaelwongycn/job-portal/domain/config"
+)
+
+const (
+	NoopScannerType  = "none"
+	ClamdScannerType = "clamd"
+
+	defaultClamdTimeout = 30 * time.Second
+	clamdChunkSize      = 64 << 10
+)
+
+// ErrInfected is returned when the scanner finds malware in a file.
+var ErrInfected = errors.New("file is infected")
+
+// Scanner checks uploaded files for malware before they are stored. A scanner
+// that can't reach its engine returns an error other than ErrInfected, and
+// callers refuse the upload rather than store an unscanned file.
+type Scanner interface {
+	Scan(ctx context.Context, name string, data []byte) error
+}
+
+func NewScanner(cfg config.ScannerConfig) (Scanner, error) {
+	switch cfg.Type {
+	case NoopScannerType, "":
+		return NewNoopScanner(), nil
+	case ClamdScannerType:
+		if cfg.Address == "" {
+			return nil, errors.New("clamd scanner needs an address")
+		}
+		timeout := cfg.Timeout * time.Second
+		if timeout <= 0 {
+			timeout = defaultClamdTimeout
+		}
+		return NewClamdScanner(cfg.Address, timeout), nil
+	default:
+		return nil, fmt.Errorf("unknown scanner type %s", cfg.Type)
+	}
+}
+
+type noopScanner struct{}
+
+// NewNoopScanner accepts every file. It is the default, for deployments that
+// scan at another layer or not at all.
+func NewNoopScanner() Scanner {
+	return &noopScanner{}
+}
+
+func (s *noopScanner) Scan(ctx context.Context, name string, data []byte) error {
+	return nil
+}
+
+type clamdScanner struct {
+	address string
+	timeout time.Duration
+}
+
+// NewClamdScanner streams files to a ClamAV daemon listening on a TCP address
+// such as "localhost:3310", using its INSTREAM command.
+func NewClamdScanner(address string, timeout time.Duration) Scanner {
+	return &clamdScanner{
+		address: address,
+		timeout: timeout,
+	}
+}
+
+func (s *clamdScanner) Scan(ctx context.Context, name string, data []byte) error {
+	dialer := net.Dialer{Timeout: s.timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", s.address)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	deadline := time.Now().Add(s.timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	conn.SetDeadline(deadline)
+
+	_, err = conn.Write([]byte("zINSTREAM\x00"))
+	if err != nil {
+		return err
+	}
+
+	// The stream is sent as chunks prefixed with their big endian length and
+	// ends with a zero length chunk.
+	size := make([]byte, 4)
+	for len(data) > 0 {
+		chunk := data
+		if len(chunk) > clamdChunkSize {
+			chunk = chunk[:clamdChunkSize]
+		}
+		binary.BigEndian.PutUint32(size, uint32(len(chunk)))
+		_, err = conn.Write(append(size, chunk...))
+		if err != nil {
+			return err
+		}
+		data = data[len(chunk):]
+	}
+	binary.BigEndian.PutUint32(size, 0)
+	_, err = conn.Write(size)
+	if err != nil {
+		return err
+	}
+
+	reply, err := bufio.NewReader(conn).ReadString('\x00')
+	if err != nil {
+		return err
+	}
+	reply = strings.TrimSuffix(reply, "\x00")
+
+	switch {
+	case strings.HasSuffix(reply, " OK"):
+		return nil
+	case strings.HasSuffix(reply, " FOUND"):
+		return fmt.Errorf("%w: %s", ErrInfected, strings.TrimSuffix(strings.TrimPrefix(reply, "stream: "), " FOUND"))
+	default:
+		return fmt.Errorf("clamd scan of %s failed: %s", name, reply)
+	}
+}
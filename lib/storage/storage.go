@@ -0,0 +1,137 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/michaelwongycn/job-portal/domain/config"
+)
+
+const (
+	LocalStorageType = "local"
+	S3StorageType    = "s3"
+
+	defaultLocalPath = "uploads"
+)
+
+var (
+	ErrNotFound   = errors.New("object not found")
+	ErrInvalidKey = errors.New("invalid object key")
+)
+
+// Storage keeps uploaded files as opaque objects addressed by key. Keys are
+// slash separated paths such as "attachments/12/3f9a...", and never contain
+// "." or ".." segments.
+type Storage interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+func NewStorage(cfg config.StorageConfig) (Storage, error) {
+	switch cfg.Type {
+	case LocalStorageType, "":
+		localPath := cfg.LocalPath
+		if localPath == "" {
+			localPath = defaultLocalPath
+		}
+		return NewLocalStorage(localPath), nil
+	case S3StorageType:
+		return NewS3Storage(cfg)
+	default:
+		return nil, fmt.Errorf("unknown storage type %s", cfg.Type)
+	}
+}
+
+// ValidKey reports whether key is a relative, clean slash separated path.
+func ValidKey(key string) bool {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return false
+	}
+	return path.Clean(key) == key && key != "." && key != ".." && !strings.HasPrefix(key, "../")
+}
+
+type localStorage struct {
+	root string
+}
+
+// NewLocalStorage keeps objects as files below root, creating directories as
+// needed.
+func NewLocalStorage(root string) Storage {
+	return &localStorage{
+		root: root,
+	}
+}
+
+func (s *localStorage) path(key string) (string, error) {
+	if !ValidKey(key) {
+		return "", ErrInvalidKey
+	}
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
+
+// Put writes the object to a temporary file first and renames it into place,
+// so readers never see a partly written file.
+func (s *localStorage) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	name, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(name), 0755)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(name), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	_, err = file.Write(data)
+	if err != nil {
+		file.Close()
+		return err
+	}
+	err = file.Close()
+	if err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), name)
+}
+
+func (s *localStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	name, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(name)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return file, nil
+}
+
+func (s *localStorage) Delete(ctx context.Context, key string) error {
+	name, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(name)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,209 @@
+package text
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Tokenize lower-cases s and splits it into words. Characters commonly found
+// inside technology names, such as the ones in "c++", "c#" and "node.js", are
+// kept as part of the word, while sentence punctuation around it is dropped.
+func Tokenize(s string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '+' && r != '#' && r != '.'
+	})
+
+	tokens := fields[:0]
+	for _, field := range fields {
+		field = strings.Trim(field, ".")
+		if field != "" {
+			tokens = append(tokens, field)
+		}
+	}
+	return tokens
+}
+
+// ContainsAll reports whether every word of query appears in document.
+func ContainsAll(document, query string) bool {
+	words := make(map[string]bool)
+	for _, word := range Tokenize(document) {
+		words[word] = true
+	}
+
+	for _, word := range Tokenize(query) {
+		if !words[word] {
+			return false
+		}
+	}
+	return true
+}
+
+var stopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "at": true, "be": true, "by": true,
+	"for": true, "from": true, "has": true, "have": true, "in": true, "is": true, "it": true, "its": true,
+	"of": true, "on": true, "or": true, "our": true, "that": true, "the": true, "their": true, "this": true,
+	"to": true, "we": true, "will": true, "with": true, "you": true, "your": true,
+}
+
+// Terms tokenizes s and drops stop words, leaving the words that carry
+// meaning when comparing documents.
+func Terms(s string) []string {
+	tokens := Tokenize(s)
+	terms := tokens[:0]
+	for _, token := range tokens {
+		if !stopWords[token] {
+			terms = append(terms, token)
+		}
+	}
+	return terms
+}
+
+// Corpus holds the document frequency of every term in a set of documents,
+// which is what TF-IDF weighting needs to tell rare terms from common ones.
+type Corpus struct {
+	documents         int
+	documentFrequency map[string]int
+}
+
+func NewCorpus(documents [][]string) *Corpus {
+	corpus := &Corpus{
+		documents:         len(documents),
+		documentFrequency: make(map[string]int),
+	}
+	for _, document := range documents {
+		seen := make(map[string]bool, len(document))
+		for _, term := range document {
+			if !seen[term] {
+				seen[term] = true
+				corpus.documentFrequency[term]++
+			}
+		}
+	}
+	return corpus
+}
+
+// Vector weights every term of document by its term frequency times its
+// smoothed inverse document frequency in the corpus.
+func (c *Corpus) Vector(document []string) map[string]float64 {
+	vector := make(map[string]float64)
+	if len(document) == 0 {
+		return vector
+	}
+
+	for _, term := range document {
+		vector[term]++
+	}
+	for term, count := range vector {
+		idf := math.Log(float64(c.documents+1)/float64(c.documentFrequency[term]+1)) + 1
+		vector[term] = count / float64(len(document)) * idf
+	}
+	return vector
+}
+
+// Cosine returns the cosine similarity of two term vectors, from 0 for no
+// shared terms to 1 for identical weighting.
+func Cosine(a, b map[string]float64) float64 {
+	var dot, normA, normB float64
+	for term, weight := range a {
+		dot += weight * b[term]
+		normA += weight * weight
+	}
+	for _, weight := range b {
+		normB += weight * weight
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
+
+// TopSharedTerms returns up to n terms present in both vectors, ordered by
+// how much they contribute to the similarity of the two.
+func TopSharedTerms(a, b map[string]float64, n int) []string {
+	terms := []string{}
+	for term := range a {
+		if b[term] > 0 {
+			terms = append(terms, term)
+		}
+	}
+
+	sort.Slice(terms, func(i, j int) bool {
+		wi, wj := a[terms[i]]*b[terms[i]], a[terms[j]]*b[terms[j]]
+		if wi != wj {
+			return wi > wj
+		}
+		return terms[i] < terms[j]
+	})
+
+	if len(terms) > n {
+		terms = terms[:n]
+	}
+	return terms
+}
+
+// Normalize reduces s to its lower-cased words separated by single spaces, so
+// strings differing only in case, punctuation or spacing compare equal.
+func Normalize(s string) string {
+	return strings.Join(Tokenize(s), " ")
+}
+
+// Shingles returns the set of k consecutive words found in s. Texts shorter
+// than k words yield a single shingle holding all of them.
+func Shingles(s string, k int) map[string]bool {
+	tokens := Tokenize(s)
+	shingles := make(map[string]bool)
+	if len(tokens) == 0 {
+		return shingles
+	}
+	if len(tokens) < k {
+		shingles[strings.Join(tokens, " ")] = true
+		return shingles
+	}
+
+	for i := 0; i+k <= len(tokens); i++ {
+		shingles[strings.Join(tokens[i:i+k], " ")] = true
+	}
+	return shingles
+}
+
+// Jaccard returns the size of the intersection of a and b divided by the size
+// of their union. Two empty sets are considered identical.
+func Jaccard(a, b map[string]bool) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 1
+	}
+
+	shared := 0
+	for shingle := range a {
+		if b[shingle] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(a)+len(b)-shared)
+}
+
+// ContainsPhrase reports whether the words of phrase appear next to each other
+// in document.
+func ContainsPhrase(document, phrase string) bool {
+	words := Tokenize(phrase)
+	if len(words) == 0 {
+		return false
+	}
+
+	tokens := Tokenize(document)
+	for i := 0; i+len(words) <= len(tokens); i++ {
+		match := true
+		for j, word := range words {
+			if tokens[i+j] != word {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
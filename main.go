@@ -14,8 +14,15 @@ import (
 	"github.com/michaelwongycn/job-portal/lib/cfg"
 	"github.com/michaelwongycn/job-portal/lib/db"
 	"github.com/michaelwongycn/job-portal/lib/encrypt"
+	"github.com/michaelwongycn/job-portal/lib/notifier"
+	"github.com/michaelwongycn/job-portal/lib/scanner"
+	"github.com/michaelwongycn/job-portal/lib/storage"
 	"github.com/michaelwongycn/job-portal/repository/appDB"
+	"github.com/michaelwongycn/job-portal/usecase/attachment"
+	"github.com/michaelwongycn/job-portal/usecase/company"
+	"github.com/michaelwongycn/job-portal/usecase/feed"
 	"github.com/michaelwongycn/job-portal/usecase/job"
+	"github.com/michaelwongycn/job-portal/usecase/profile"
 	"github.com/michaelwongycn/job-portal/usecase/user"
 )
 
@@ -35,17 +42,48 @@ func main() {
 		log.Printf("Error connecting to DB: %v\n", err)
 	}
 
+	notifier, err := notifier.NewNotifier(cfg.Notifier)
+	if err != nil {
+		log.Fatalf("Error creating notifier: %v\n", err)
+	}
+
+	storage, err := storage.NewStorage(cfg.Storage)
+	if err != nil {
+		log.Fatalf("Error creating storage: %v\n", err)
+	}
+
+	scanner, err := scanner.NewScanner(cfg.Scanner)
+	if err != nil {
+		log.Fatalf("Error creating scanner: %v\n", err)
+	}
+
 	appDB := appDB.NewAppDBImpl(60, db)
 
 	userUsecase := user.NewUserImpl(appDB, cfg.JWT.RefreshTokenDuration)
-	JobUsecase := job.NewJobImpl(appDB, cfg.JWT.RefreshTokenDuration)
+	JobUsecase := job.NewJobImpl(appDB, cfg.JWT.RefreshTokenDuration, notifier, cfg.Moderation, cfg.Applications)
+	companyUsecase := company.NewCompanyImpl(appDB)
+	feedUsecase := feed.NewFeedImpl(appDB, cfg.Feed)
+	attachmentUsecase := attachment.NewAttachmentImpl(appDB, storage, scanner, cfg.Storage)
+	profileUsecase := profile.NewProfileImpl(appDB)
 
-	controller := controller.NewControllerImpl(userUsecase, JobUsecase)
+	controller := controller.NewControllerImpl(userUsecase, JobUsecase, companyUsecase, feedUsecase, attachmentUsecase, profileUsecase)
 
 	handler := handler.NewHandler(60, controller)
 
 	rest := handler.StartRoute()
 
+	if cfg.Alert.DigestInterval > 0 {
+		go func() {
+			ticker := time.NewTicker(time.Minute * cfg.Alert.DigestInterval)
+			defer ticker.Stop()
+			for range ticker.C {
+				if err := JobUsecase.SendSavedSearchDigest(context.Background()); err != nil {
+					log.Printf("Error sending saved search digest: %v\n", err)
+				}
+			}
+		}()
+	}
+
 	quit := make(chan os.Signal)
 	signal.Notify(quit, os.Interrupt)
 	<-quit
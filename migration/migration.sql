@@ -5,7 +5,8 @@ CREATE TABLE users (
     id SERIAL PRIMARY KEY,
     email VARCHAR(255) UNIQUE NOT NULL,
     password VARCHAR(255) NOT NULL,
-    role INTEGER NOT NULL
+    role INTEGER NOT NULL,
+    is_verified BOOLEAN NOT NULL DEFAULT FALSE
 );
 
 CREATE TABLE user_tokens (
@@ -22,7 +23,18 @@ CREATE TABLE jobs (
     title VARCHAR(255) NOT NULL,
     description TEXT NOT NULL,
     requirement TEXT NOT NULL,
-    create_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+    create_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    job_status INTEGER NOT NULL DEFAULT 1,
+    expire_date TIMESTAMP,
+    openings INTEGER,
+    max_applications INTEGER,
+    moderation_status INTEGER NOT NULL DEFAULT 1,
+    city VARCHAR(255),
+    latitude DOUBLE PRECISION,
+    longitude DOUBLE PRECISION,
+    language VARCHAR(35) NOT NULL DEFAULT 'en',
+    required_fields TEXT[] NOT NULL DEFAULT '{}',
+    auto_closed BOOLEAN NOT NULL DEFAULT FALSE
 );
 
 CREATE TABLE applications (
@@ -30,6 +42,267 @@ CREATE TABLE applications (
     job_id INTEGER REFERENCES jobs(id) ON DELETE CASCADE,
     talent_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
     application_status INTEGER NOT NULL DEFAULT 1,
-    apply_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+    apply_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    cover_letter TEXT NOT NULL DEFAULT '',
+    expected_salary BIGINT,
+    available_from DATE,
+    links TEXT[] NOT NULL DEFAULT '{}',
+    profile_snapshot JSONB
+);
+
+CREATE UNIQUE INDEX applications_active_job_talent_key ON applications (job_id, talent_id) WHERE application_status <> 5;
+
+CREATE TABLE skills (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) UNIQUE NOT NULL
+);
+
+CREATE TABLE skill_aliases (
+    alias VARCHAR(100) PRIMARY KEY,
+    skill_id INTEGER NOT NULL REFERENCES skills(id) ON DELETE CASCADE
+);
+
+CREATE TABLE job_skills (
+    job_id INTEGER REFERENCES jobs(id) ON DELETE CASCADE,
+    skill_id INTEGER REFERENCES skills(id) ON DELETE CASCADE,
+    PRIMARY KEY (job_id, skill_id)
+);
+
+CREATE TABLE companies (
+    id SERIAL PRIMARY KEY,
+    employer_id INTEGER UNIQUE NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    website VARCHAR(255) NOT NULL DEFAULT '',
+    description TEXT NOT NULL DEFAULT '',
+    size VARCHAR(20) NOT NULL DEFAULT '',
+    industry VARCHAR(100) NOT NULL DEFAULT '',
+    logo_path VARCHAR(255) NOT NULL DEFAULT '',
+    create_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE saved_jobs (
+    talent_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
+    job_id INTEGER REFERENCES jobs(id) ON DELETE CASCADE,
+    save_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (talent_id, job_id)
+);
+
+CREATE TABLE saved_searches (
+    id SERIAL PRIMARY KEY,
+    talent_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    keywords VARCHAR(255) NOT NULL DEFAULT '',
+    skills TEXT[] NOT NULL DEFAULT '{}',
+    company_id INTEGER REFERENCES companies(id) ON DELETE CASCADE,
+    frequency INTEGER NOT NULL DEFAULT 1,
+    create_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE saved_search_matches (
+    saved_search_id INTEGER REFERENCES saved_searches(id) ON DELETE CASCADE,
+    job_id INTEGER REFERENCES jobs(id) ON DELETE CASCADE,
+    match_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    notify_date TIMESTAMP,
+    PRIMARY KEY (saved_search_id, job_id)
+);
+
+CREATE TABLE talent_skills (
+    talent_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
+    skill_id INTEGER REFERENCES skills(id) ON DELETE CASCADE,
+    PRIMARY KEY (talent_id, skill_id)
+);
+
+CREATE TABLE job_questions (
+    id SERIAL PRIMARY KEY,
+    job_id INTEGER REFERENCES jobs(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    question TEXT NOT NULL,
+    question_type INTEGER NOT NULL,
+    options TEXT[] NOT NULL DEFAULT '{}',
+    knockout_answers TEXT[] NOT NULL DEFAULT '{}',
+    knockout_min NUMERIC,
+    knockout_max NUMERIC
+);
+
+CREATE TABLE application_answers (
+    application_id INTEGER REFERENCES applications(id) ON DELETE CASCADE,
+    question_id INTEGER REFERENCES job_questions(id) ON DELETE CASCADE,
+    answer TEXT NOT NULL,
+    knocked_out BOOLEAN NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (application_id, question_id)
+);
+
+CREATE TABLE job_imports (
+    id SERIAL PRIMARY KEY,
+    employer_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
+    import_status INTEGER NOT NULL DEFAULT 1,
+    mode VARCHAR(20) NOT NULL,
+    total_rows INTEGER NOT NULL DEFAULT 0,
+    inserted_rows INTEGER NOT NULL DEFAULT 0,
+    failed_rows INTEGER NOT NULL DEFAULT 0,
+    report JSONB NOT NULL DEFAULT '[]',
+    create_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    finish_date TIMESTAMP
+);
+
+CREATE TABLE job_revisions (
+    id SERIAL PRIMARY KEY,
+    job_id INTEGER REFERENCES jobs(id) ON DELETE CASCADE,
+    revision INTEGER NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL,
+    requirement TEXT NOT NULL,
+    skills TEXT[] NOT NULL DEFAULT '{}',
+    expire_date TIMESTAMP,
+    create_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (job_id, revision)
+);
+
+ALTER TABLE applications ADD COLUMN job_revision_id INTEGER REFERENCES job_revisions(id);
+
+CREATE TABLE job_moderations (
+    id SERIAL PRIMARY KEY,
+    job_id INTEGER REFERENCES jobs(id) ON DELETE CASCADE,
+    flags TEXT[] NOT NULL DEFAULT '{}',
+    create_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    review_date TIMESTAMP,
+    reviewer_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    decision INTEGER,
+    reason TEXT
+);
+
+CREATE TABLE job_translations (
+    job_id INTEGER REFERENCES jobs(id) ON DELETE CASCADE,
+    language VARCHAR(35) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL,
+    requirement TEXT NOT NULL,
+    PRIMARY KEY (job_id, language)
+);
+
+CREATE TABLE job_reports (
+    id SERIAL PRIMARY KEY,
+    job_id INTEGER REFERENCES jobs(id) ON DELETE CASCADE,
+    talent_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
+    category INTEGER NOT NULL,
+    details TEXT NOT NULL DEFAULT '',
+    report_status INTEGER NOT NULL DEFAULT 1,
+    create_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    review_date TIMESTAMP,
+    reviewer_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    resolution TEXT,
     UNIQUE (job_id, talent_id)
 );
+
+CREATE TABLE application_status_history (
+    id SERIAL PRIMARY KEY,
+    application_id INTEGER REFERENCES applications(id) ON DELETE CASCADE,
+    from_status INTEGER,
+    to_status INTEGER NOT NULL,
+    actor_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    note TEXT NOT NULL DEFAULT '',
+    change_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE attachments (
+    id SERIAL PRIMARY KEY,
+    talent_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
+    kind INTEGER NOT NULL,
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(255) NOT NULL,
+    size BIGINT NOT NULL,
+    storage_key VARCHAR(255) UNIQUE NOT NULL,
+    create_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE application_attachments (
+    application_id INTEGER REFERENCES applications(id) ON DELETE CASCADE,
+    attachment_id INTEGER REFERENCES attachments(id) ON DELETE CASCADE,
+    PRIMARY KEY (application_id, attachment_id)
+);
+
+CREATE TABLE talent_profiles (
+    talent_id INTEGER PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    headline VARCHAR(255) NOT NULL DEFAULT '',
+    summary TEXT NOT NULL DEFAULT '',
+    links TEXT[] NOT NULL DEFAULT '{}',
+    visibility INTEGER NOT NULL DEFAULT 2,
+    update_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE talent_experiences (
+    id SERIAL PRIMARY KEY,
+    talent_id INTEGER NOT NULL REFERENCES talent_profiles(talent_id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    company VARCHAR(255) NOT NULL,
+    start_date DATE NOT NULL,
+    end_date DATE,
+    description TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TABLE talent_educations (
+    id SERIAL PRIMARY KEY,
+    talent_id INTEGER NOT NULL REFERENCES talent_profiles(talent_id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    school VARCHAR(255) NOT NULL,
+    degree VARCHAR(255) NOT NULL DEFAULT '',
+    field_of_study VARCHAR(255) NOT NULL DEFAULT '',
+    start_date DATE NOT NULL,
+    end_date DATE
+);
+
+CREATE TABLE application_notes (
+    id SERIAL PRIMARY KEY,
+    application_id INTEGER NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+    author_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    body TEXT NOT NULL,
+    create_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE application_tags (
+    application_id INTEGER REFERENCES applications(id) ON DELETE CASCADE,
+    tag VARCHAR(50) NOT NULL,
+    PRIMARY KEY (application_id, tag)
+);
+
+CREATE TABLE scorecard_criteria (
+    id SERIAL PRIMARY KEY,
+    job_id INTEGER NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    weight INTEGER NOT NULL DEFAULT 1,
+    UNIQUE (job_id, name)
+);
+
+CREATE TABLE application_scores (
+    application_id INTEGER REFERENCES applications(id) ON DELETE CASCADE,
+    criterion_id INTEGER REFERENCES scorecard_criteria(id) ON DELETE CASCADE,
+    reviewer_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
+    score INTEGER NOT NULL,
+    update_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (application_id, criterion_id, reviewer_id)
+);
+
+CREATE TABLE interview_slots (
+    id SERIAL PRIMARY KEY,
+    job_id INTEGER NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+    employer_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    start_date TIMESTAMP NOT NULL,
+    end_date TIMESTAMP NOT NULL,
+    location VARCHAR(255) NOT NULL DEFAULT '',
+    create_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE interviews (
+    application_id INTEGER PRIMARY KEY REFERENCES applications(id) ON DELETE CASCADE,
+    slot_id INTEGER REFERENCES interview_slots(id) ON DELETE SET NULL,
+    start_date TIMESTAMP NOT NULL,
+    end_date TIMESTAMP NOT NULL,
+    location VARCHAR(255) NOT NULL DEFAULT '',
+    interview_status INTEGER NOT NULL DEFAULT 1,
+    sequence INTEGER NOT NULL DEFAULT 0,
+    update_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX interviews_booked_slot_key ON interviews (slot_id) WHERE interview_status = 1;
@@ -3,8 +3,14 @@ package appDB
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 
+	"github.com/lib/pq"
+	"github.com/michaelwongycn/job-portal/domain/enum"
 	"github.com/michaelwongycn/job-portal/domain/model"
 	"github.com/michaelwongycn/job-portal/lib/log"
 )
@@ -13,8 +19,13 @@ const (
 	noRowsFoundErrorMsg      = "no rows found for the query"
 	errorScanningRowErrorMsg = "error when scanning row"
 	errorQueryingSQLErrorMsg = "error when querying SQL"
+
+	uniqueViolationCode     = "23505"
+	interviewsBookedSlotKey = "interviews_booked_slot_key"
 )
 
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
 type appDBImpl struct {
 	db      *sql.DB
 	timeout time.Duration
@@ -47,6 +58,44 @@ func (d *appDBImpl) GetUserByEmailAndPassword(ctx context.Context, email, passwo
 	return &data, nil
 }
 
+func (d *appDBImpl) GetUserById(ctx context.Context, userId int) (*model.User, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	var data model.User
+	row := d.db.QueryRowContext(ctx, getUserByIdQuery, userId)
+
+	err := row.Scan(&data.ID, &data.Email, &data.Role, &data.IsVerified)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			log.PrintLogErr(ctx, noRowsFoundErrorMsg, err)
+			return nil, err
+		} else {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return nil, err
+		}
+	}
+	return &data, nil
+}
+
+func (d *appDBImpl) VerifyEmployer(ctx context.Context, employerId int) error {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	result, err := d.db.ExecContext(ctx, verifyEmployerQuery, employerId, enum.EmployerRole)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return err
+	}
+
+	err = requireRowsAffected(result)
+	if err != nil {
+		log.PrintLogErr(ctx, noRowsFoundErrorMsg, err)
+		return err
+	}
+	return nil
+}
+
 func (d *appDBImpl) InsertUser(ctx context.Context, email, password string, role int) (int, error) {
 	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
 	defer cancelfunc()
@@ -143,20 +192,27 @@ func (d *appDBImpl) DeleteUserToken(ctx context.Context, userId int) error {
 	return nil
 }
 
-func (d *appDBImpl) GetAllJob(ctx context.Context) (*[]model.Job, error) {
+func (d *appDBImpl) GetAllJob(ctx context.Context, filter model.JobFilter) (*[]model.Job, error) {
 	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
 	defer cancelfunc()
 
-	rows, err := d.db.QueryContext(ctx, getAllJobQuery)
+	var minLatitude, maxLatitude, minLongitude, maxLongitude *float64
+	if filter.Bounds != nil {
+		minLatitude, maxLatitude = &filter.Bounds.MinLatitude, &filter.Bounds.MaxLatitude
+		minLongitude, maxLongitude = &filter.Bounds.MinLongitude, &filter.Bounds.MaxLongitude
+	}
+
+	rows, err := d.db.QueryContext(ctx, getAllJobQuery, pq.Array(filter.Skills), filter.EmployerId, filter.OpenOnly, minLatitude, maxLatitude, minLongitude, maxLongitude)
 	if err != nil {
 		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
 		return nil, err
 	}
+	defer rows.Close()
 
 	var data []model.Job
 	for rows.Next() {
 		var job model.Job
-		err := rows.Scan(&job.ID, &job.EmployerId, &job.Title, &job.Description, &job.Requirement, &job.CreateDate)
+		err := rows.Scan(jobScanDest(&job)...)
 		if err != nil {
 			if err == sql.ErrNoRows {
 				log.PrintLogErr(ctx, noRowsFoundErrorMsg, err)
@@ -179,7 +235,7 @@ func (d *appDBImpl) GetJobById(ctx context.Context, jobId int) (*model.Job, erro
 	row := d.db.QueryRowContext(ctx, getJobByIdQuery, jobId)
 
 	var job model.Job
-	err := row.Scan(&job.ID, &job.EmployerId, &job.Title, &job.Description, &job.Requirement, &job.CreateDate)
+	err := row.Scan(jobScanDest(&job)...)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			log.PrintLogErr(ctx, noRowsFoundErrorMsg, err)
@@ -192,7 +248,87 @@ func (d *appDBImpl) GetJobById(ctx context.Context, jobId int) (*model.Job, erro
 	return &job, nil
 }
 
-func (d *appDBImpl) InsertJob(ctx context.Context, employerId int, title, description, requirement string) error {
+func (d *appDBImpl) InsertJob(ctx context.Context, job model.Job) (int, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	id, err := insertJob(ctx, tx, job)
+	if err != nil {
+		return 0, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// InsertJobs inserts every job in one transaction. When atomic is false each
+// job runs under its own savepoint, so a failing job only leaves a zero id at
+// its index instead of aborting the rest.
+func (d *appDBImpl) InsertJobs(ctx context.Context, jobs []model.Job, atomic bool) ([]int, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	ids := make([]int, len(jobs))
+	for i, job := range jobs {
+		if atomic {
+			ids[i], err = insertJob(ctx, tx, job)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		_, err = tx.ExecContext(ctx, savepointQuery)
+		if err != nil {
+			log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+			return nil, err
+		}
+
+		ids[i], err = insertJob(ctx, tx, job)
+		if err != nil {
+			_, err = tx.ExecContext(ctx, rollbackToSavepointQuery)
+			if err != nil {
+				log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+				return nil, err
+			}
+			continue
+		}
+
+		_, err = tx.ExecContext(ctx, releaseSavepointQuery)
+		if err != nil {
+			log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// UpdateJob replaces the editable fields of the job and records the result as
+// a new revision, unless it matches the latest one. The row lock taken by the
+// update serialises concurrent edits, so revision numbers stay unique.
+func (d *appDBImpl) UpdateJob(ctx context.Context, job model.Job) error {
 	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
 	defer cancelfunc()
 
@@ -202,12 +338,51 @@ func (d *appDBImpl) InsertJob(ctx context.Context, employerId int, title, descri
 	}
 	defer tx.Rollback()
 
-	_, err = tx.ExecContext(ctx, insertJobQuery, employerId, title, description, requirement)
+	result, err := tx.ExecContext(ctx, updateJobQuery, job.Title, job.Description, job.Requirement, job.ExpireDate, job.Openings, job.MaxApplications, job.ModerationStatus, job.City, job.Latitude, job.Longitude, job.Language, pq.Array(job.RequiredFields), job.ID, job.EmployerId)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return err
+	}
+
+	err = requireRowsAffected(result)
+	if err != nil {
+		log.PrintLogErr(ctx, noRowsFoundErrorMsg, err)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, deleteJobSkillsQuery, job.ID)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return err
+	}
+
+	err = insertJobSkills(ctx, tx, job.ID, job.Skills)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, deleteJobTranslationsQuery, job.ID)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return err
+	}
+
+	err = insertJobTranslations(ctx, tx, job.ID, job.Translations)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, insertJobRevisionQuery, job.ID)
 	if err != nil {
 		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
 		return err
 	}
 
+	err = insertJobModeration(ctx, tx, job.ID, job.ModerationFlags)
+	if err != nil {
+		return err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return err
@@ -216,43 +391,38 @@ func (d *appDBImpl) InsertJob(ctx context.Context, employerId int, title, descri
 	return nil
 }
 
-func (d *appDBImpl) GetApplicationsByJobId(ctx context.Context, jobId, employerId int) (*[]model.Application, error) {
+func (d *appDBImpl) GetJobRevisions(ctx context.Context, jobId int) (*[]model.JobRevision, error) {
 	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
 	defer cancelfunc()
 
-	rows, err := d.db.QueryContext(ctx, getApplicationsByJobIdQuery, jobId, employerId)
+	rows, err := d.db.QueryContext(ctx, getJobRevisionsQuery, jobId)
 	if err != nil {
 		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
 		return nil, err
 	}
+	defer rows.Close()
 
-	var data []model.Application
+	data := []model.JobRevision{}
 	for rows.Next() {
-		var application model.Application
-		err := rows.Scan(&application.ID, &application.JobId, &application.TalentId, &application.ApplicationStatus, &application.ApplyDate)
+		var revision model.JobRevision
+		err := rows.Scan(jobRevisionScanDest(&revision)...)
 		if err != nil {
-			if err == sql.ErrNoRows {
-				log.PrintLogErr(ctx, noRowsFoundErrorMsg, err)
-				return nil, err
-			} else {
-				log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
-
-				return nil, err
-			}
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return nil, err
 		}
-		data = append(data, application)
+		data = append(data, revision)
 	}
 	return &data, nil
 }
 
-func (d *appDBImpl) GetApplicationByIdAndEmployeerId(ctx context.Context, applicationId, employerId int) (*model.Application, error) {
+func (d *appDBImpl) GetJobRevision(ctx context.Context, jobId, revision int) (*model.JobRevision, error) {
 	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
 	defer cancelfunc()
 
-	var data model.Application
-	row := d.db.QueryRowContext(ctx, getApplicationByIdAndEmployerIdQuery, applicationId, employerId)
+	var data model.JobRevision
+	row := d.db.QueryRowContext(ctx, getJobRevisionQuery, jobId, revision)
 
-	err := row.Scan(&data.ID, &data.JobId, &data.TalentId, &data.ApplicationStatus, &data.ApplyDate)
+	err := row.Scan(jobRevisionScanDest(&data)...)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			log.PrintLogErr(ctx, noRowsFoundErrorMsg, err)
@@ -265,27 +435,31 @@ func (d *appDBImpl) GetApplicationByIdAndEmployeerId(ctx context.Context, applic
 	return &data, nil
 }
 
-func (d *appDBImpl) GetApplicationByIdAndTalentId(ctx context.Context, applicationId, talentId int) (*model.Application, error) {
+func (d *appDBImpl) GetRecentJobsByEmployerId(ctx context.Context, employerId, days int) (*[]model.Job, error) {
 	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
 	defer cancelfunc()
 
-	var data model.Application
-	row := d.db.QueryRowContext(ctx, getApplicationByIdAndTalentIdQuery, applicationId, talentId)
-
-	err := row.Scan(&data.ID, &data.JobId, &data.TalentId, &data.ApplicationStatus, &data.ApplyDate)
+	rows, err := d.db.QueryContext(ctx, getRecentJobsByEmployerIdQuery, employerId, days)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			log.PrintLogErr(ctx, noRowsFoundErrorMsg, err)
-			return nil, err
-		} else {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	data := []model.Job{}
+	for rows.Next() {
+		var job model.Job
+		err := rows.Scan(jobScanDest(&job)...)
+		if err != nil {
 			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
 			return nil, err
 		}
+		data = append(data, job)
 	}
 	return &data, nil
 }
 
-func (d *appDBImpl) InsertApplication(ctx context.Context, jobId, talentId int) error {
+func (d *appDBImpl) UpdateJobStatus(ctx context.Context, jobId, employerId, status int) error {
 	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
 	defer cancelfunc()
 
@@ -295,12 +469,18 @@ func (d *appDBImpl) InsertApplication(ctx context.Context, jobId, talentId int)
 	}
 	defer tx.Rollback()
 
-	_, err = tx.ExecContext(ctx, insertApplicationQuery, jobId, talentId)
+	result, err := tx.ExecContext(ctx, updateJobStatusQuery, status, jobId, employerId)
 	if err != nil {
 		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
 		return err
 	}
 
+	err = requireRowsAffected(result)
+	if err != nil {
+		log.PrintLogErr(ctx, noRowsFoundErrorMsg, err)
+		return err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return err
@@ -309,7 +489,118 @@ func (d *appDBImpl) InsertApplication(ctx context.Context, jobId, talentId int)
 	return nil
 }
 
-func (d *appDBImpl) UpdateApplicationStatus(ctx context.Context, applicationId, status int) error {
+func (d *appDBImpl) GetJobsByEmployerId(ctx context.Context, employerId int, sortBy, sortOrder string, limit, offset int) (*[]model.EmployerJob, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	query := fmt.Sprintf(getJobsByEmployerIdQuery, employerJobSortColumns[sortBy], sortOrder, sortOrder)
+	rows, err := d.db.QueryContext(ctx, query, employerId, limit, offset)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	data := []model.EmployerJob{}
+	for rows.Next() {
+		var job model.EmployerJob
+		err := rows.Scan(append(jobScanDest(&job.Job),
+			&job.ApplicationCount.Created, &job.ApplicationCount.Interview, &job.ApplicationCount.Accepted, &job.ApplicationCount.Declined, &job.ApplicationCount.Withdrawn, &job.ApplicationCount.Total)...)
+		if err != nil {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return nil, err
+		}
+		data = append(data, job)
+	}
+	return &data, nil
+}
+
+func (d *appDBImpl) CountJobsByEmployerId(ctx context.Context, employerId int) (int, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	var total int
+	err := d.db.QueryRowContext(ctx, countJobsByEmployerIdQuery, employerId).Scan(&total)
+	if err != nil {
+		log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+		return 0, err
+	}
+	return total, nil
+}
+
+func (d *appDBImpl) SearchSkills(ctx context.Context, prefix string, limit int) (*[]model.Skill, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	rows, err := d.db.QueryContext(ctx, searchSkillsQuery, likeEscaper.Replace(prefix)+"%", limit)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	data := []model.Skill{}
+	for rows.Next() {
+		var skill model.Skill
+		err := rows.Scan(&skill.ID, &skill.Name, pq.Array(&skill.Aliases))
+		if err != nil {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return nil, err
+		}
+		data = append(data, skill)
+	}
+	return &data, nil
+}
+
+func (d *appDBImpl) ResolveSkillNames(ctx context.Context, names []string) ([]string, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	rows, err := d.db.QueryContext(ctx, resolveSkillNamesQuery, pq.Array(names))
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	data := []string{}
+	for rows.Next() {
+		var name string
+		err := rows.Scan(&name)
+		if err != nil {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return nil, err
+		}
+		data = append(data, name)
+	}
+	return data, nil
+}
+
+func (d *appDBImpl) GetTalentSkills(ctx context.Context, talentId int) ([]string, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	rows, err := d.db.QueryContext(ctx, getTalentSkillsQuery, talentId)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	data := []string{}
+	for rows.Next() {
+		var name string
+		err := rows.Scan(&name)
+		if err != nil {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return nil, err
+		}
+		data = append(data, name)
+	}
+	return data, nil
+}
+
+func (d *appDBImpl) UpdateTalentSkills(ctx context.Context, talentId int, skills []string) error {
 	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
 	defer cancelfunc()
 
@@ -319,12 +610,25 @@ func (d *appDBImpl) UpdateApplicationStatus(ctx context.Context, applicationId,
 	}
 	defer tx.Rollback()
 
-	_, err = tx.ExecContext(ctx, updateApplicationStatusQuery, status, applicationId)
+	_, err = tx.ExecContext(ctx, deleteTalentSkillsQuery, talentId)
 	if err != nil {
 		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
 		return err
 	}
 
+	for _, name := range skills {
+		skillId, err := getOrInsertSkillId(ctx, tx, name)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, insertTalentSkillQuery, talentId, skillId)
+		if err != nil {
+			log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+			return err
+		}
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return err
@@ -332,3 +636,2006 @@ func (d *appDBImpl) UpdateApplicationStatus(ctx context.Context, applicationId,
 
 	return nil
 }
+
+func (d *appDBImpl) GetAppliedJobsByTalentId(ctx context.Context, talentId, limit int) (*[]model.Job, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	rows, err := d.db.QueryContext(ctx, getAppliedJobsByTalentIdQuery, talentId, limit)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	data := []model.Job{}
+	for rows.Next() {
+		var job model.Job
+		err := rows.Scan(jobScanDest(&job)...)
+		if err != nil {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return nil, err
+		}
+		data = append(data, job)
+	}
+	return &data, nil
+}
+
+func (d *appDBImpl) GetJobQuestions(ctx context.Context, jobId int) (*[]model.JobQuestion, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	rows, err := d.db.QueryContext(ctx, getJobQuestionsQuery, jobId)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	data := []model.JobQuestion{}
+	for rows.Next() {
+		var question model.JobQuestion
+		err := rows.Scan(&question.ID, &question.JobId, &question.Position, &question.Question, &question.QuestionType, pq.Array(&question.Options), pq.Array(&question.KnockoutAnswers), &question.KnockoutMin, &question.KnockoutMax)
+		if err != nil {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return nil, err
+		}
+		data = append(data, question)
+	}
+	return &data, nil
+}
+
+func (d *appDBImpl) GetJobTranslations(ctx context.Context, jobIds []int) (*[]model.JobTranslation, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	rows, err := d.db.QueryContext(ctx, getJobTranslationsQuery, pq.Array(jobIds))
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	data := []model.JobTranslation{}
+	for rows.Next() {
+		var translation model.JobTranslation
+		err := rows.Scan(&translation.JobId, &translation.Language, &translation.Title, &translation.Description, &translation.Requirement)
+		if err != nil {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return nil, err
+		}
+		data = append(data, translation)
+	}
+	return &data, nil
+}
+
+func (d *appDBImpl) GetModerationJobs(ctx context.Context, limit, offset int) (*[]model.ModerationJob, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	rows, err := d.db.QueryContext(ctx, getModerationJobsQuery, limit, offset)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	data := []model.ModerationJob{}
+	for rows.Next() {
+		var moderationJob model.ModerationJob
+		err := rows.Scan(append(jobScanDest(&moderationJob.Job), pq.Array(&moderationJob.Flags), &moderationJob.QueueDate)...)
+		if err != nil {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return nil, err
+		}
+		data = append(data, moderationJob)
+	}
+	return &data, nil
+}
+
+func (d *appDBImpl) CountModerationJobs(ctx context.Context) (int, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	var total int
+	err := d.db.QueryRowContext(ctx, countModerationJobsQuery).Scan(&total)
+	if err != nil {
+		log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+		return 0, err
+	}
+	return total, nil
+}
+
+// ReviewJob records an admin decision on a job waiting for moderation. It
+// returns sql.ErrNoRows when the job is not in the moderation queue.
+func (d *appDBImpl) ReviewJob(ctx context.Context, jobId, reviewerId, status int, reason string) error {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, reviewJobQuery, status, jobId)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return err
+	}
+
+	err = requireRowsAffected(result)
+	if err != nil {
+		log.PrintLogErr(ctx, noRowsFoundErrorMsg, err)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, reviewJobModerationQuery, reviewerId, status, reason, jobId)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// InsertJobReport stores a report unless the talent already reported the job.
+// It returns whether the report was stored and how many open reports the job
+// has now.
+func (d *appDBImpl) InsertJobReport(ctx context.Context, report model.JobReport) (bool, int, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return false, 0, err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, insertJobReportQuery, report.JobId, report.TalentId, report.Category, report.Details)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return false, 0, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, 0, err
+	}
+
+	var openReports int
+	err = tx.QueryRowContext(ctx, countOpenJobReportsQuery, report.JobId).Scan(&openReports)
+	if err != nil {
+		log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+		return false, 0, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, 0, err
+	}
+
+	return affected > 0, openReports, nil
+}
+
+// HideJob moves a published job back to the moderation queue. Jobs that are
+// already hidden are left alone, so the queue gets a single entry.
+func (d *appDBImpl) HideJob(ctx context.Context, jobId int, flags []string) error {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, hideReportedJobQuery, jobId)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return nil
+	}
+
+	err = insertJobModeration(ctx, tx, jobId, flags)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (d *appDBImpl) GetJobReports(ctx context.Context, status, limit, offset int) (*[]model.JobReport, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	rows, err := d.db.QueryContext(ctx, getJobReportsQuery, status, limit, offset)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	data := []model.JobReport{}
+	for rows.Next() {
+		var report model.JobReport
+		err := rows.Scan(&report.ID, &report.JobId, &report.JobTitle, &report.TalentId, &report.Category, &report.Details, &report.ReportStatus, &report.CreateDate, &report.ReviewDate, &report.ReviewerId, &report.Resolution)
+		if err != nil {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return nil, err
+		}
+		data = append(data, report)
+	}
+	return &data, nil
+}
+
+func (d *appDBImpl) CountJobReports(ctx context.Context, status int) (int, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	var total int
+	err := d.db.QueryRowContext(ctx, countJobReportsQuery, status).Scan(&total)
+	if err != nil {
+		log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+		return 0, err
+	}
+	return total, nil
+}
+
+// ResolveJobReport closes an open report and returns the reported job. An
+// upheld report closes every other open report on the job as well and
+// rejects the job.
+func (d *appDBImpl) ResolveJobReport(ctx context.Context, reportId, reviewerId, status int, resolution string) (int, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var jobId int
+	err = tx.QueryRowContext(ctx, resolveJobReportQuery, status, reviewerId, resolution, reportId).Scan(&jobId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			log.PrintLogErr(ctx, noRowsFoundErrorMsg, err)
+			return 0, err
+		} else {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return 0, err
+		}
+	}
+
+	if status == enum.UpheldReportStatus {
+		for _, query := range []string{upholdJobReportsQuery, closeJobModerationsQuery} {
+			_, err = tx.ExecContext(ctx, query, reviewerId, resolution, jobId)
+			if err != nil {
+				log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+				return 0, err
+			}
+		}
+
+		_, err = tx.ExecContext(ctx, rejectReportedJobQuery, jobId)
+		if err != nil {
+			log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+			return 0, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, err
+	}
+
+	return jobId, nil
+}
+
+func (d *appDBImpl) HasAppliedToJob(ctx context.Context, jobId, talentId int) (bool, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	var applied bool
+	err := d.db.QueryRowContext(ctx, hasAppliedToJobQuery, jobId, talentId).Scan(&applied)
+	if err != nil {
+		log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+		return false, err
+	}
+	return applied, nil
+}
+
+func (d *appDBImpl) GetApplicationsByJobId(ctx context.Context, jobId, employerId int, includeWithdrawn bool) (*[]model.Application, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	rows, err := d.db.QueryContext(ctx, getApplicationsByJobIdQuery, jobId, employerId, includeWithdrawn)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return nil, err
+	}
+
+	var data []model.Application
+	for rows.Next() {
+		var application model.Application
+		err := rows.Scan(applicationScanDest(&application)...)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				log.PrintLogErr(ctx, noRowsFoundErrorMsg, err)
+				return nil, err
+			} else {
+				log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+
+				return nil, err
+			}
+		}
+		data = append(data, application)
+	}
+	return &data, nil
+}
+
+func (d *appDBImpl) GetApplicationByIdAndEmployeerId(ctx context.Context, applicationId, employerId int) (*model.Application, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	var data model.Application
+	row := d.db.QueryRowContext(ctx, getApplicationByIdAndEmployerIdQuery, applicationId, employerId)
+
+	err := row.Scan(applicationScanDest(&data)...)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			log.PrintLogErr(ctx, noRowsFoundErrorMsg, err)
+			return nil, err
+		} else {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return nil, err
+		}
+	}
+	return &data, nil
+}
+
+func (d *appDBImpl) GetApplicationByIdAndTalentId(ctx context.Context, applicationId, talentId int) (*model.Application, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	var data model.Application
+	row := d.db.QueryRowContext(ctx, getApplicationByIdAndTalentIdQuery, applicationId, talentId)
+
+	err := row.Scan(applicationScanDest(&data)...)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			log.PrintLogErr(ctx, noRowsFoundErrorMsg, err)
+			return nil, err
+		} else {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return nil, err
+		}
+	}
+	return &data, nil
+}
+
+func (d *appDBImpl) GetApplicationsByTalentId(ctx context.Context, talentId int, statuses []int, limit, offset int) (*[]model.Application, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	rows, err := d.db.QueryContext(ctx, getApplicationsByTalentIdQuery, talentId, pq.Array(statuses), limit, offset)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	data := []model.Application{}
+	for rows.Next() {
+		var application model.Application
+		job := model.JobSummary{}
+		err := rows.Scan(append(applicationScanDest(&application), &job.ID, &job.Title, jsonColumn{&job.Company}, &job.City, &job.JobStatus, &job.ExpireDate)...)
+		if err != nil {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return nil, err
+		}
+		application.Job = &job
+		data = append(data, application)
+	}
+	return &data, nil
+}
+
+func (d *appDBImpl) CountApplicationsByTalentId(ctx context.Context, talentId int, statuses []int) (int, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	var total int
+	err := d.db.QueryRowContext(ctx, countApplicationsByTalentIdQuery, talentId, pq.Array(statuses)).Scan(&total)
+	if err != nil {
+		log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+		return 0, err
+	}
+	return total, nil
+}
+
+// InsertApplication locks the job row so concurrent applications are counted
+// one at a time against the application cap. It returns a zero id without an
+// error when the job is closed or already full, and closes the job once the
+// application fills the cap.
+func (d *appDBImpl) InsertApplication(ctx context.Context, application model.Application) (int, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	var id, jobStatus, applicationCount int
+	var maxApplications *int
+
+	// The profile snapshot is left NULL for talents who don't share one.
+	var profile interface{}
+	if application.Profile != nil {
+		data, err := json.Marshal(application.Profile)
+		if err != nil {
+			return 0, err
+		}
+		profile = data
+	}
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, lockJobForApplicationQuery, application.JobId).Scan(&jobStatus, &maxApplications, &applicationCount)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			log.PrintLogErr(ctx, noRowsFoundErrorMsg, err)
+			return 0, err
+		} else {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return 0, err
+		}
+	}
+
+	if jobStatus != enum.OpenJobStatus || (maxApplications != nil && applicationCount >= *maxApplications) {
+		return 0, nil
+	}
+
+	err = tx.QueryRowContext(ctx, insertApplicationQuery, application.JobId, application.TalentId, application.ApplicationStatus, application.CoverLetter, application.ExpectedSalary, application.AvailableFrom, pq.Array(application.Links), profile).Scan(&id)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return 0, err
+	}
+
+	for _, answer := range application.Answers {
+		_, err = tx.ExecContext(ctx, insertApplicationAnswerQuery, id, answer.QuestionId, answer.Answer, answer.KnockedOut)
+		if err != nil {
+			log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+			return 0, err
+		}
+	}
+
+	for _, attachment := range application.Attachments {
+		_, err = tx.ExecContext(ctx, insertApplicationAttachmentQuery, id, attachment.ID)
+		if err != nil {
+			log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+			return 0, err
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, insertApplicationStatusHistoryQuery, id, nil, application.ApplicationStatus, application.TalentId, "")
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return 0, err
+	}
+
+	if maxApplications != nil && applicationCount+1 >= *maxApplications {
+		_, err = tx.ExecContext(ctx, closeJobQuery, application.JobId)
+		if err != nil {
+			log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+			return 0, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (d *appDBImpl) GetApplicationAnswersByJobId(ctx context.Context, jobId int) (*[]model.ApplicationAnswer, error) {
+	return d.getApplicationAnswers(ctx, getApplicationAnswersByJobIdQuery, jobId)
+}
+
+func (d *appDBImpl) GetApplicationAnswersByApplicationId(ctx context.Context, applicationId int) (*[]model.ApplicationAnswer, error) {
+	return d.getApplicationAnswers(ctx, getApplicationAnswersByApplicationIdQuery, applicationId)
+}
+
+func (d *appDBImpl) getApplicationAnswers(ctx context.Context, query string, args ...interface{}) (*[]model.ApplicationAnswer, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	data := []model.ApplicationAnswer{}
+	for rows.Next() {
+		var answer model.ApplicationAnswer
+		err := rows.Scan(&answer.ApplicationId, &answer.QuestionId, &answer.Question, &answer.Answer, &answer.KnockedOut)
+		if err != nil {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return nil, err
+		}
+		data = append(data, answer)
+	}
+	return &data, nil
+}
+
+// UpdateApplicationStatus moves an application to change.ToStatus, records the
+// change in its history and closes the job once its accepted applications
+// reach the number of openings. A withdrawal reopens a job its caps closed
+// once it is back under them, unless it expired; jobs the employer closed stay
+// closed. The job and application rows are locked first, so concurrent
+// acceptances see each other, and false is returned without an error when the
+// status no longer is change.FromStatus because another change got there first.
+func (d *appDBImpl) UpdateApplicationStatus(ctx context.Context, change model.ApplicationStatusChange) (bool, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	var jobId, status int
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, lockApplicationJobQuery, change.ApplicationId).Scan(&jobId, &status)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			log.PrintLogErr(ctx, noRowsFoundErrorMsg, err)
+			return false, err
+		} else {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return false, err
+		}
+	}
+
+	if change.FromStatus != nil && *change.FromStatus != status {
+		return false, nil
+	}
+
+	_, err = tx.ExecContext(ctx, updateApplicationStatusQuery, change.ToStatus, change.ApplicationId)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return false, err
+	}
+
+	_, err = tx.ExecContext(ctx, insertApplicationStatusHistoryQuery, change.ApplicationId, status, change.ToStatus, change.ActorId, change.Note)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return false, err
+	}
+
+	if change.ToStatus == enum.AcceptedStatus {
+		_, err = tx.ExecContext(ctx, closeFilledJobQuery, jobId)
+		if err != nil {
+			log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+			return false, err
+		}
+	}
+
+	if change.ToStatus == enum.WithdrawnStatus {
+		_, err = tx.ExecContext(ctx, reopenJobQuery, jobId)
+		if err != nil {
+			log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+			return false, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (d *appDBImpl) GetApplicationStatusHistory(ctx context.Context, applicationId int) (*[]model.ApplicationStatusChange, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	rows, err := d.db.QueryContext(ctx, getApplicationStatusHistoryQuery, applicationId)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	data := []model.ApplicationStatusChange{}
+	for rows.Next() {
+		var change model.ApplicationStatusChange
+		err := rows.Scan(&change.ID, &change.ApplicationId, &change.FromStatus, &change.ToStatus, &change.ActorId, &change.Note, &change.ChangeDate)
+		if err != nil {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return nil, err
+		}
+		data = append(data, change)
+	}
+	return &data, nil
+}
+
+// GetLastWithdrawDate returns when the talent last withdrew an application to
+// the job, or nil when they never did.
+func (d *appDBImpl) GetLastWithdrawDate(ctx context.Context, jobId, talentId int) (*time.Time, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	var withdrawDate *time.Time
+	err := d.db.QueryRowContext(ctx, getLastWithdrawDateQuery, jobId, talentId).Scan(&withdrawDate)
+	if err != nil {
+		log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+		return nil, err
+	}
+	return withdrawDate, nil
+}
+
+func (d *appDBImpl) InsertApplicationNote(ctx context.Context, note model.ApplicationNote) (*model.ApplicationNote, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, insertApplicationNoteQuery, note.ApplicationId, note.AuthorId, note.Body).Scan(&note.ID, &note.CreateDate)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &note, nil
+}
+
+func (d *appDBImpl) GetApplicationNotes(ctx context.Context, applicationId int) (*[]model.ApplicationNote, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	rows, err := d.db.QueryContext(ctx, getApplicationNotesQuery, applicationId)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	data := []model.ApplicationNote{}
+	for rows.Next() {
+		var note model.ApplicationNote
+		err := rows.Scan(&note.ID, &note.ApplicationId, &note.AuthorId, &note.Body, &note.CreateDate)
+		if err != nil {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return nil, err
+		}
+		data = append(data, note)
+	}
+	return &data, nil
+}
+
+// DeleteApplicationNote deletes a note written by authorId. Notes written by
+// someone else are reported as missing.
+func (d *appDBImpl) DeleteApplicationNote(ctx context.Context, noteId, applicationId, authorId int) error {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, deleteApplicationNoteQuery, noteId, applicationId, authorId)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return err
+	}
+
+	err = requireRowsAffected(result)
+	if err != nil {
+		log.PrintLogErr(ctx, noRowsFoundErrorMsg, err)
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (d *appDBImpl) UpdateApplicationTags(ctx context.Context, applicationId int, tags []string) error {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, deleteApplicationTagsQuery, applicationId)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return err
+	}
+
+	for _, tag := range tags {
+		_, err = tx.ExecContext(ctx, insertApplicationTagQuery, applicationId, tag)
+		if err != nil {
+			log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (d *appDBImpl) GetApplicationTags(ctx context.Context, applicationId int) (*[]model.ApplicationTag, error) {
+	return d.getApplicationTags(ctx, getApplicationTagsQuery, applicationId)
+}
+
+func (d *appDBImpl) GetApplicationTagsByJobId(ctx context.Context, jobId int) (*[]model.ApplicationTag, error) {
+	return d.getApplicationTags(ctx, getApplicationTagsByJobIdQuery, jobId)
+}
+
+func (d *appDBImpl) getApplicationTags(ctx context.Context, query string, args ...interface{}) (*[]model.ApplicationTag, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	data := []model.ApplicationTag{}
+	for rows.Next() {
+		var tag model.ApplicationTag
+		err := rows.Scan(&tag.ApplicationId, &tag.Tag)
+		if err != nil {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return nil, err
+		}
+		data = append(data, tag)
+	}
+	return &data, nil
+}
+
+func (d *appDBImpl) GetScorecardCriteria(ctx context.Context, jobId int) (*[]model.ScorecardCriterion, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	rows, err := d.db.QueryContext(ctx, getScorecardCriteriaQuery, jobId)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	data := []model.ScorecardCriterion{}
+	for rows.Next() {
+		var criterion model.ScorecardCriterion
+		err := rows.Scan(&criterion.ID, &criterion.JobId, &criterion.Position, &criterion.Name, &criterion.Weight)
+		if err != nil {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return nil, err
+		}
+		data = append(data, criterion)
+	}
+	return &data, nil
+}
+
+// UpdateScorecardCriteria replaces the criteria of a job. Criteria are matched
+// by name, so scores given on a criterion that is kept survive the update
+// while scores on removed criteria are deleted with them.
+func (d *appDBImpl) UpdateScorecardCriteria(ctx context.Context, jobId int, criteria []model.ScorecardCriterion) error {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	names := make([]string, 0, len(criteria))
+	for _, criterion := range criteria {
+		names = append(names, criterion.Name)
+	}
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, deleteScorecardCriteriaQuery, jobId, pq.Array(names))
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return err
+	}
+
+	for _, criterion := range criteria {
+		_, err = tx.ExecContext(ctx, upsertScorecardCriterionQuery, jobId, criterion.Position, criterion.Name, criterion.Weight)
+		if err != nil {
+			log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateApplicationScores replaces the scores a reviewer gave an application.
+func (d *appDBImpl) UpdateApplicationScores(ctx context.Context, applicationId, reviewerId int, scores []model.CriterionScore) error {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, deleteApplicationScoresQuery, applicationId, reviewerId)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return err
+	}
+
+	for _, score := range scores {
+		_, err = tx.ExecContext(ctx, insertApplicationScoreQuery, applicationId, score.CriterionId, reviewerId, score.Score)
+		if err != nil {
+			log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (d *appDBImpl) GetApplicationScores(ctx context.Context, applicationId int) (*[]model.CriterionScore, error) {
+	return d.getCriterionScores(ctx, getApplicationScoresQuery, applicationId)
+}
+
+func (d *appDBImpl) GetApplicationScoresByJobId(ctx context.Context, jobId int) (*[]model.CriterionScore, error) {
+	return d.getCriterionScores(ctx, getApplicationScoresByJobIdQuery, jobId)
+}
+
+func (d *appDBImpl) getCriterionScores(ctx context.Context, query string, args ...interface{}) (*[]model.CriterionScore, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	data := []model.CriterionScore{}
+	for rows.Next() {
+		var score model.CriterionScore
+		err := rows.Scan(&score.ApplicationId, &score.ReviewerId, &score.CriterionId, &score.Name, &score.Weight, &score.Score)
+		if err != nil {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return nil, err
+		}
+		data = append(data, score)
+	}
+	return &data, nil
+}
+
+// InsertInterviewSlots adds the employer's slots unless one of them overlaps
+// a slot the employer already has, including the other slots being added.
+// The employer is locked so concurrent requests can't add overlapping slots.
+func (d *appDBImpl) InsertInterviewSlots(ctx context.Context, employerId int, slots []model.InterviewSlot) (bool, *[]model.InterviewSlot, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return false, nil, err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, lockUserQuery, employerId)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return false, nil, err
+	}
+
+	data := []model.InterviewSlot{}
+	for _, slot := range slots {
+		var overlapping int
+		err = tx.QueryRowContext(ctx, countOverlappingInterviewSlotsQuery, employerId, slot.StartDate, slot.EndDate).Scan(&overlapping)
+		if err != nil {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return false, nil, err
+		}
+		if overlapping > 0 {
+			return false, nil, nil
+		}
+
+		slot.EmployerId = employerId
+		err = tx.QueryRowContext(ctx, insertInterviewSlotQuery, slot.JobId, employerId, slot.StartDate, slot.EndDate, slot.Location).Scan(&slot.ID, &slot.CreateDate)
+		if err != nil {
+			log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+			return false, nil, err
+		}
+		data = append(data, slot)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, nil, err
+	}
+
+	return true, &data, nil
+}
+
+func (d *appDBImpl) GetInterviewSlotsByJobId(ctx context.Context, jobId int) (*[]model.InterviewSlot, error) {
+	return d.getInterviewSlots(ctx, getInterviewSlotsByJobIdQuery, jobId)
+}
+
+// GetAvailableInterviewSlots returns the job's slots that start after the
+// given time and aren't booked.
+func (d *appDBImpl) GetAvailableInterviewSlots(ctx context.Context, jobId int, after time.Time) (*[]model.InterviewSlot, error) {
+	return d.getInterviewSlots(ctx, getAvailableInterviewSlotsQuery, jobId, after)
+}
+
+func (d *appDBImpl) getInterviewSlots(ctx context.Context, query string, args ...interface{}) (*[]model.InterviewSlot, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	data := []model.InterviewSlot{}
+	for rows.Next() {
+		var slot model.InterviewSlot
+		err := rows.Scan(interviewSlotScanDest(&slot)...)
+		if err != nil {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return nil, err
+		}
+		data = append(data, slot)
+	}
+	return &data, nil
+}
+
+func (d *appDBImpl) GetInterviewSlotById(ctx context.Context, slotId int) (*model.InterviewSlot, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	var data model.InterviewSlot
+	row := d.db.QueryRowContext(ctx, getInterviewSlotByIdQuery, slotId)
+
+	err := row.Scan(interviewSlotScanDest(&data)...)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			log.PrintLogErr(ctx, noRowsFoundErrorMsg, err)
+			return nil, err
+		} else {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return nil, err
+		}
+	}
+	return &data, nil
+}
+
+// DeleteInterviewSlot deletes one of the employer's slots and cancels the
+// interview booked on it, which is returned so the talent can be told. The
+// interview is nil when the slot wasn't booked.
+func (d *appDBImpl) DeleteInterviewSlot(ctx context.Context, slotId, employerId int) (*model.Interview, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var id int
+	err = tx.QueryRowContext(ctx, lockInterviewSlotQuery, slotId, employerId).Scan(&id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			log.PrintLogErr(ctx, noRowsFoundErrorMsg, err)
+			return nil, err
+		} else {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return nil, err
+		}
+	}
+
+	var interview *model.Interview
+	var cancelled model.Interview
+	err = tx.QueryRowContext(ctx, cancelInterviewBySlotIdQuery, slotId).Scan(interviewScanDest(&cancelled)...)
+	if err != nil && err != sql.ErrNoRows {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return nil, err
+	}
+	if err == nil {
+		interview = &cancelled
+	}
+
+	_, err = tx.ExecContext(ctx, deleteInterviewSlotQuery, slotId)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return interview, nil
+}
+
+// BookInterview books the slot for the application, moving its interview
+// there when one is already booked. It reports false when the slot is booked
+// by another application or overlaps another interview of the talent. The
+// talent and the slot are locked, so neither can be booked twice at once.
+func (d *appDBImpl) BookInterview(ctx context.Context, applicationId, talentId, slotId int) (bool, *model.Interview, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return false, nil, err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, lockUserQuery, talentId)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return false, nil, err
+	}
+
+	var startDate, endDate time.Time
+	err = tx.QueryRowContext(ctx, lockInterviewSlotForBookingQuery, slotId).Scan(&startDate, &endDate)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			log.PrintLogErr(ctx, noRowsFoundErrorMsg, err)
+			return false, nil, err
+		} else {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return false, nil, err
+		}
+	}
+
+	// Counted once the slot is locked, so a booking committed while waiting
+	// for the lock is seen.
+	var booked int
+	err = tx.QueryRowContext(ctx, countInterviewSlotBookingsQuery, slotId, applicationId).Scan(&booked)
+	if err != nil {
+		log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+		return false, nil, err
+	}
+	if booked > 0 {
+		return false, nil, nil
+	}
+
+	var conflicts int
+	err = tx.QueryRowContext(ctx, countTalentInterviewConflictsQuery, talentId, applicationId, startDate, endDate).Scan(&conflicts)
+	if err != nil {
+		log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+		return false, nil, err
+	}
+	if conflicts > 0 {
+		return false, nil, nil
+	}
+
+	var data model.Interview
+	err = tx.QueryRowContext(ctx, bookInterviewQuery, applicationId, slotId).Scan(interviewScanDest(&data)...)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == uniqueViolationCode && pqErr.Constraint == interviewsBookedSlotKey {
+			return false, nil, nil
+		}
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return false, nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, nil, err
+	}
+
+	return true, &data, nil
+}
+
+// CancelInterview cancels the interview booked for the application, freeing
+// its slot. It returns sql.ErrNoRows when no interview is booked.
+func (d *appDBImpl) CancelInterview(ctx context.Context, applicationId int) (*model.Interview, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var data model.Interview
+	err = tx.QueryRowContext(ctx, cancelInterviewQuery, applicationId).Scan(interviewScanDest(&data)...)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			log.PrintLogErr(ctx, noRowsFoundErrorMsg, err)
+			return nil, err
+		} else {
+			log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
+
+func (d *appDBImpl) GetInterview(ctx context.Context, applicationId int) (*model.Interview, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	var data model.Interview
+	row := d.db.QueryRowContext(ctx, getInterviewQuery, applicationId)
+
+	err := row.Scan(interviewScanDest(&data)...)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			log.PrintLogErr(ctx, noRowsFoundErrorMsg, err)
+			return nil, err
+		} else {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return nil, err
+		}
+	}
+	return &data, nil
+}
+
+func (d *appDBImpl) InsertAttachment(ctx context.Context, attachment model.Attachment) (int, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	var id int
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, insertAttachmentQuery, attachment.TalentId, attachment.Kind, attachment.FileName, attachment.ContentType, attachment.Size, attachment.StorageKey).Scan(&id)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return 0, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (d *appDBImpl) GetAttachmentById(ctx context.Context, attachmentId int) (*model.Attachment, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	var data model.Attachment
+	row := d.db.QueryRowContext(ctx, getAttachmentByIdQuery, attachmentId)
+
+	err := row.Scan(attachmentScanDest(&data)...)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			log.PrintLogErr(ctx, noRowsFoundErrorMsg, err)
+			return nil, err
+		} else {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return nil, err
+		}
+	}
+	return &data, nil
+}
+
+func (d *appDBImpl) GetAttachmentsByTalentId(ctx context.Context, talentId int) (*[]model.Attachment, error) {
+	return d.getAttachments(ctx, false, getAttachmentsByTalentIdQuery, talentId)
+}
+
+func (d *appDBImpl) GetAttachmentsByIds(ctx context.Context, attachmentIds []int, talentId int) (*[]model.Attachment, error) {
+	return d.getAttachments(ctx, false, getAttachmentsByIdsQuery, pq.Array(attachmentIds), talentId)
+}
+
+func (d *appDBImpl) GetAttachmentsByApplicationId(ctx context.Context, applicationId int) (*[]model.Attachment, error) {
+	return d.getAttachments(ctx, true, getAttachmentsByApplicationIdQuery, applicationId)
+}
+
+func (d *appDBImpl) GetAttachmentsByJobId(ctx context.Context, jobId int) (*[]model.Attachment, error) {
+	return d.getAttachments(ctx, true, getAttachmentsByJobIdQuery, jobId)
+}
+
+// getAttachments runs an attachment query. Queries loading attachments for
+// applications select the application id first, which withApplication tells.
+func (d *appDBImpl) getAttachments(ctx context.Context, withApplication bool, query string, args ...interface{}) (*[]model.Attachment, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	data := []model.Attachment{}
+	for rows.Next() {
+		var attachment model.Attachment
+		dest := attachmentScanDest(&attachment)
+		if withApplication {
+			dest = append([]interface{}{&attachment.ApplicationId}, dest...)
+		}
+		err := rows.Scan(dest...)
+		if err != nil {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return nil, err
+		}
+		data = append(data, attachment)
+	}
+	return &data, nil
+}
+
+func (d *appDBImpl) IsAttachmentSharedWithEmployer(ctx context.Context, attachmentId, employerId int) (bool, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	var shared bool
+	err := d.db.QueryRowContext(ctx, isAttachmentSharedWithEmployerQuery, attachmentId, employerId).Scan(&shared)
+	if err != nil {
+		log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+		return false, err
+	}
+	return shared, nil
+}
+
+func (d *appDBImpl) UpsertCompany(ctx context.Context, company model.Company) error {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, upsertCompanyQuery, company.EmployerId, company.Name, company.Website, company.Description, company.Size, company.Industry, company.LogoPath)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (d *appDBImpl) GetCompanyById(ctx context.Context, companyId int) (*model.Company, error) {
+	return d.getCompany(ctx, getCompanyByIdQuery, companyId)
+}
+
+func (d *appDBImpl) GetCompanyByEmployerId(ctx context.Context, employerId int) (*model.Company, error) {
+	return d.getCompany(ctx, getCompanyByEmployerIdQuery, employerId)
+}
+
+func (d *appDBImpl) getCompany(ctx context.Context, query string, args ...interface{}) (*model.Company, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	var company model.Company
+	row := d.db.QueryRowContext(ctx, query, args...)
+
+	err := row.Scan(&company.ID, &company.EmployerId, &company.Name, &company.Website, &company.Description, &company.Size, &company.Industry, &company.LogoPath, &company.CreateDate)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			log.PrintLogErr(ctx, noRowsFoundErrorMsg, err)
+			return nil, err
+		} else {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return nil, err
+		}
+	}
+	return &company, nil
+}
+
+// UpsertTalentProfile creates or replaces the talent's profile, including its
+// work history and education.
+func (d *appDBImpl) UpsertTalentProfile(ctx context.Context, profile model.TalentProfile) error {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, upsertTalentProfileQuery, profile.TalentId, profile.Headline, profile.Summary, pq.Array(profile.Links), profile.Visibility)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, deleteTalentExperiencesQuery, profile.TalentId)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return err
+	}
+
+	for i, experience := range profile.Experiences {
+		_, err = tx.ExecContext(ctx, insertTalentExperienceQuery, profile.TalentId, i+1, experience.Title, experience.Company, experience.StartDate, experience.EndDate, experience.Description)
+		if err != nil {
+			log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+			return err
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, deleteTalentEducationsQuery, profile.TalentId)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return err
+	}
+
+	for i, education := range profile.Educations {
+		_, err = tx.ExecContext(ctx, insertTalentEducationQuery, profile.TalentId, i+1, education.School, education.Degree, education.FieldOfStudy, education.StartDate, education.EndDate)
+		if err != nil {
+			log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (d *appDBImpl) GetTalentProfile(ctx context.Context, talentId int) (*model.TalentProfile, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	profile := model.TalentProfile{
+		Experiences: []model.WorkExperience{},
+		Educations:  []model.Education{},
+	}
+	row := d.db.QueryRowContext(ctx, getTalentProfileQuery, talentId)
+
+	err := row.Scan(&profile.TalentId, &profile.Headline, &profile.Summary, pq.Array(&profile.Links), &profile.Visibility, &profile.UpdateDate, pq.Array(&profile.Skills))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			log.PrintLogErr(ctx, noRowsFoundErrorMsg, err)
+			return nil, err
+		} else {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return nil, err
+		}
+	}
+
+	rows, err := d.db.QueryContext(ctx, getTalentExperiencesQuery, talentId)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var experience model.WorkExperience
+		err := rows.Scan(&experience.Title, &experience.Company, &experience.StartDate, &experience.EndDate, &experience.Description)
+		if err != nil {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return nil, err
+		}
+		profile.Experiences = append(profile.Experiences, experience)
+	}
+
+	rows, err = d.db.QueryContext(ctx, getTalentEducationsQuery, talentId)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var education model.Education
+		err := rows.Scan(&education.School, &education.Degree, &education.FieldOfStudy, &education.StartDate, &education.EndDate)
+		if err != nil {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return nil, err
+		}
+		profile.Educations = append(profile.Educations, education)
+	}
+	return &profile, nil
+}
+
+func (d *appDBImpl) DeleteTalentProfile(ctx context.Context, talentId int) error {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, deleteTalentProfileQuery, talentId)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return err
+	}
+
+	err = requireRowsAffected(result)
+	if err != nil {
+		log.PrintLogErr(ctx, noRowsFoundErrorMsg, err)
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (d *appDBImpl) HasAppliedToEmployer(ctx context.Context, talentId, employerId int) (bool, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	var applied bool
+	err := d.db.QueryRowContext(ctx, hasAppliedToEmployerQuery, talentId, employerId).Scan(&applied)
+	if err != nil {
+		log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+		return false, err
+	}
+	return applied, nil
+}
+
+func (d *appDBImpl) InsertSavedJob(ctx context.Context, talentId, jobId int) error {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, insertSavedJobQuery, talentId, jobId)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (d *appDBImpl) DeleteSavedJob(ctx context.Context, talentId, jobId int) error {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, deleteSavedJobQuery, talentId, jobId)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return err
+	}
+
+	err = requireRowsAffected(result)
+	if err != nil {
+		log.PrintLogErr(ctx, noRowsFoundErrorMsg, err)
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (d *appDBImpl) GetSavedJobs(ctx context.Context, talentId, limit, offset int) (*[]model.SavedJob, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	rows, err := d.db.QueryContext(ctx, getSavedJobsQuery, talentId, limit, offset)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	data := []model.SavedJob{}
+	for rows.Next() {
+		var savedJob model.SavedJob
+		err := rows.Scan(append(jobScanDest(&savedJob.Job), &savedJob.SaveDate)...)
+		if err != nil {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return nil, err
+		}
+		data = append(data, savedJob)
+	}
+	return &data, nil
+}
+
+func (d *appDBImpl) CountSavedJobs(ctx context.Context, talentId int) (int, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	var total int
+	err := d.db.QueryRowContext(ctx, countSavedJobsQuery, talentId).Scan(&total)
+	if err != nil {
+		log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+		return 0, err
+	}
+	return total, nil
+}
+
+func (d *appDBImpl) InsertSavedSearch(ctx context.Context, savedSearch model.SavedSearch) error {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, insertSavedSearchQuery, savedSearch.TalentId, savedSearch.Name, savedSearch.Keywords, pq.Array(savedSearch.Skills), savedSearch.CompanyId, savedSearch.Frequency)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (d *appDBImpl) GetSavedSearchesByTalentId(ctx context.Context, talentId int) (*[]model.SavedSearch, error) {
+	return d.getSavedSearches(ctx, getSavedSearchesByTalentIdQuery, talentId)
+}
+
+func (d *appDBImpl) GetSavedSearchesBySkills(ctx context.Context, skills []string, companyId *int) (*[]model.SavedSearch, error) {
+	return d.getSavedSearches(ctx, getSavedSearchesBySkillsQuery, pq.Array(skills), companyId)
+}
+
+func (d *appDBImpl) getSavedSearches(ctx context.Context, query string, args ...interface{}) (*[]model.SavedSearch, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	data := []model.SavedSearch{}
+	for rows.Next() {
+		var savedSearch model.SavedSearch
+		err := rows.Scan(savedSearchScanDest(&savedSearch)...)
+		if err != nil {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return nil, err
+		}
+		data = append(data, savedSearch)
+	}
+	return &data, nil
+}
+
+func (d *appDBImpl) DeleteSavedSearch(ctx context.Context, savedSearchId, talentId int) error {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, deleteSavedSearchQuery, savedSearchId, talentId)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return err
+	}
+
+	err = requireRowsAffected(result)
+	if err != nil {
+		log.PrintLogErr(ctx, noRowsFoundErrorMsg, err)
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (d *appDBImpl) InsertSavedSearchMatch(ctx context.Context, savedSearchId, jobId int) (bool, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, insertSavedSearchMatchQuery, savedSearchId, jobId)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (d *appDBImpl) GetPendingSavedSearchMatches(ctx context.Context) (*[]model.SavedSearchMatch, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	rows, err := d.db.QueryContext(ctx, getPendingSavedSearchMatchesQuery)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	data := []model.SavedSearchMatch{}
+	for rows.Next() {
+		var match model.SavedSearchMatch
+		dest := append(savedSearchScanDest(&match.SavedSearch), jobScanDest(&match.Job)...)
+		err := rows.Scan(append(dest, &match.MatchDate)...)
+		if err != nil {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return nil, err
+		}
+		data = append(data, match)
+	}
+	return &data, nil
+}
+
+func (d *appDBImpl) UpdateSavedSearchMatchesNotified(ctx context.Context, matches []model.SavedSearchMatch) error {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, match := range matches {
+		_, err = tx.ExecContext(ctx, updateSavedSearchMatchNotifiedQuery, match.SavedSearch.ID, match.Job.ID)
+		if err != nil {
+			log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (d *appDBImpl) InsertJobImport(ctx context.Context, jobImport *model.JobImport) error {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	err := d.db.QueryRowContext(ctx, insertJobImportQuery, jobImport.EmployerId, jobImport.ImportStatus, jobImport.Mode, jobImport.TotalRows).Scan(&jobImport.ID, &jobImport.CreateDate)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return err
+	}
+	return nil
+}
+
+func (d *appDBImpl) UpdateJobImport(ctx context.Context, jobImport model.JobImport) error {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	report, err := json.Marshal(jobImport.Report)
+	if err != nil {
+		return err
+	}
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, updateJobImportQuery, jobImport.ImportStatus, jobImport.InsertedRows, jobImport.FailedRows, report, jobImport.FinishDate, jobImport.ID)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return err
+	}
+
+	err = requireRowsAffected(result)
+	if err != nil {
+		log.PrintLogErr(ctx, noRowsFoundErrorMsg, err)
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (d *appDBImpl) GetJobImportByIdAndEmployerId(ctx context.Context, importId, employerId int) (*model.JobImport, error) {
+	ctx, cancelfunc := context.WithTimeout(ctx, d.timeout)
+	defer cancelfunc()
+
+	var data model.JobImport
+
+	row := d.db.QueryRowContext(ctx, getJobImportByIdAndEmployerIdQuery, importId, employerId)
+	err := row.Scan(&data.ID, &data.EmployerId, &data.ImportStatus, &data.Mode, &data.TotalRows, &data.InsertedRows, &data.FailedRows, jsonColumn{&data.Report}, &data.CreateDate, &data.FinishDate)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			log.PrintLogErr(ctx, noRowsFoundErrorMsg, err)
+			return nil, err
+		} else {
+			log.PrintLogErr(ctx, errorScanningRowErrorMsg, err)
+			return nil, err
+		}
+	}
+	return &data, nil
+}
+
+func jobScanDest(job *model.Job) []interface{} {
+	return []interface{}{&job.ID, &job.EmployerId, &job.Title, &job.Description, &job.Requirement, &job.CreateDate, pq.Array(&job.Skills), jsonColumn{&job.Company}, &job.JobStatus, &job.ExpireDate, &job.Openings, &job.MaxApplications, &job.ModerationStatus, &job.City, &job.Latitude, &job.Longitude, &job.Language, pq.Array(&job.RequiredFields)}
+}
+
+func applicationScanDest(application *model.Application) []interface{} {
+	return []interface{}{&application.ID, &application.JobId, &application.TalentId, &application.ApplicationStatus, &application.ApplyDate, &application.JobRevisionId, &application.CoverLetter, &application.ExpectedSalary, &application.AvailableFrom, pq.Array(&application.Links), jsonColumn{&application.Profile}}
+}
+
+func jobRevisionScanDest(revision *model.JobRevision) []interface{} {
+	return []interface{}{&revision.ID, &revision.JobId, &revision.Revision, &revision.Title, &revision.Description, &revision.Requirement, pq.Array(&revision.Skills), &revision.ExpireDate, &revision.CreateDate}
+}
+
+func attachmentScanDest(attachment *model.Attachment) []interface{} {
+	return []interface{}{&attachment.ID, &attachment.TalentId, &attachment.Kind, &attachment.FileName, &attachment.ContentType, &attachment.Size, &attachment.StorageKey, &attachment.CreateDate}
+}
+
+func interviewSlotScanDest(slot *model.InterviewSlot) []interface{} {
+	return []interface{}{&slot.ID, &slot.JobId, &slot.EmployerId, &slot.StartDate, &slot.EndDate, &slot.Location, &slot.ApplicationId, &slot.CreateDate}
+}
+
+func interviewScanDest(interview *model.Interview) []interface{} {
+	return []interface{}{&interview.ApplicationId, &interview.SlotId, &interview.StartDate, &interview.EndDate, &interview.Location, &interview.InterviewStatus, &interview.Sequence, &interview.UpdateDate}
+}
+
+func savedSearchScanDest(savedSearch *model.SavedSearch) []interface{} {
+	return []interface{}{&savedSearch.ID, &savedSearch.TalentId, &savedSearch.TalentEmail, &savedSearch.Name, &savedSearch.Keywords, pq.Array(&savedSearch.Skills), &savedSearch.CompanyId, &savedSearch.Frequency, &savedSearch.CreateDate}
+}
+
+// jsonColumn scans a JSON column into dest, leaving dest untouched when the
+// column is NULL.
+type jsonColumn struct {
+	dest interface{}
+}
+
+func (c jsonColumn) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		return nil
+	case []byte:
+		return json.Unmarshal(v, c.dest)
+	case string:
+		return json.Unmarshal([]byte(v), c.dest)
+	default:
+		return fmt.Errorf("unsupported JSON column type %T", src)
+	}
+}
+
+// insertJob inserts the job along with its skills and screening questions.
+func insertJob(ctx context.Context, tx *sql.Tx, job model.Job) (int, error) {
+	var id int
+
+	err := tx.QueryRowContext(ctx, insertJobQuery, job.EmployerId, job.Title, job.Description, job.Requirement, job.ExpireDate, job.Openings, job.MaxApplications, job.ModerationStatus, job.City, job.Latitude, job.Longitude, job.Language, pq.Array(job.RequiredFields)).Scan(&id)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return 0, err
+	}
+
+	err = insertJobSkills(ctx, tx, id, job.Skills)
+	if err != nil {
+		return 0, err
+	}
+
+	err = insertJobTranslations(ctx, tx, id, job.Translations)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, question := range job.Questions {
+		_, err = tx.ExecContext(ctx, insertJobQuestionQuery, id, question.Position, question.Question, question.QuestionType, pq.Array(question.Options), pq.Array(question.KnockoutAnswers), question.KnockoutMin, question.KnockoutMax)
+		if err != nil {
+			log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+			return 0, err
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, insertJobRevisionQuery, id)
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return 0, err
+	}
+
+	err = insertJobModeration(ctx, tx, id, job.ModerationFlags)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func insertJobTranslations(ctx context.Context, tx *sql.Tx, jobId int, translations []model.JobTranslation) error {
+	for _, translation := range translations {
+		_, err := tx.ExecContext(ctx, insertJobTranslationQuery, jobId, translation.Language, translation.Title, translation.Description, translation.Requirement)
+		if err != nil {
+			log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+			return err
+		}
+	}
+	return nil
+}
+
+// insertJobModeration queues the job for review when the spam filter flagged
+// it.
+func insertJobModeration(ctx context.Context, tx *sql.Tx, jobId int, flags []string) error {
+	if len(flags) == 0 {
+		return nil
+	}
+
+	_, err := tx.ExecContext(ctx, insertJobModerationQuery, jobId, pq.Array(flags))
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return err
+	}
+	return nil
+}
+
+// insertJobSkills links the job to each skill, resolving aliases to their
+// canonical skill and creating skills that are not yet in the taxonomy.
+func insertJobSkills(ctx context.Context, tx *sql.Tx, jobId int, skills []string) error {
+	for _, name := range skills {
+		skillId, err := getOrInsertSkillId(ctx, tx, name)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, insertJobSkillQuery, jobId, skillId)
+		if err != nil {
+			log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+			return err
+		}
+	}
+	return nil
+}
+
+func getOrInsertSkillId(ctx context.Context, tx *sql.Tx, name string) (int, error) {
+	var skillId int
+	err := tx.QueryRowContext(ctx, getSkillIdByNameQuery, name).Scan(&skillId)
+	if err == sql.ErrNoRows {
+		err = tx.QueryRowContext(ctx, insertSkillQuery, name).Scan(&skillId)
+	}
+	if err != nil {
+		log.PrintLogErr(ctx, errorQueryingSQLErrorMsg, err)
+		return 0, err
+	}
+	return skillId, nil
+}
+
+// requireRowsAffected reports sql.ErrNoRows when a statement matched nothing,
+// so callers can tell a missing or foreign row apart from a successful write.
+func requireRowsAffected(result sql.Result) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package appDB
 
 import (
 	"context"
+	"time"
 
 	"github.com/michaelwongycn/job-portal/domain/model"
 )
@@ -12,13 +13,101 @@ type AppDBInterface interface {
 	GetUserToken(ctx context.Context, userId int) (*model.UserToken, error)
 	InsertUserToken(ctx context.Context, userId int, accessToken, refreshToken string, expirationTime int64) error
 	DeleteUserToken(ctx context.Context, userId int) error
+	GetUserById(ctx context.Context, userId int) (*model.User, error)
+	VerifyEmployer(ctx context.Context, employerId int) error
 
-	GetAllJob(ctx context.Context) (*[]model.Job, error)
+	GetAllJob(ctx context.Context, filter model.JobFilter) (*[]model.Job, error)
 	GetJobById(ctx context.Context, jobId int) (*model.Job, error)
-	InsertJob(ctx context.Context, employerId int, title, description, requirement string) error
-	GetApplicationsByJobId(ctx context.Context, jobId, employerId int) (*[]model.Application, error)
+	InsertJob(ctx context.Context, job model.Job) (int, error)
+	InsertJobs(ctx context.Context, jobs []model.Job, atomic bool) ([]int, error)
+	UpdateJob(ctx context.Context, job model.Job) error
+	GetRecentJobsByEmployerId(ctx context.Context, employerId, days int) (*[]model.Job, error)
+	UpdateJobStatus(ctx context.Context, jobId, employerId, status int) error
+	GetJobQuestions(ctx context.Context, jobId int) (*[]model.JobQuestion, error)
+	GetJobTranslations(ctx context.Context, jobIds []int) (*[]model.JobTranslation, error)
+	GetModerationJobs(ctx context.Context, limit, offset int) (*[]model.ModerationJob, error)
+	CountModerationJobs(ctx context.Context) (int, error)
+	ReviewJob(ctx context.Context, jobId, reviewerId, status int, reason string) error
+
+	InsertJobReport(ctx context.Context, report model.JobReport) (bool, int, error)
+	HideJob(ctx context.Context, jobId int, flags []string) error
+	GetJobReports(ctx context.Context, status, limit, offset int) (*[]model.JobReport, error)
+	CountJobReports(ctx context.Context, status int) (int, error)
+	ResolveJobReport(ctx context.Context, reportId, reviewerId, status int, resolution string) (int, error)
+	GetJobRevisions(ctx context.Context, jobId int) (*[]model.JobRevision, error)
+	GetJobRevision(ctx context.Context, jobId, revision int) (*model.JobRevision, error)
+	GetJobsByEmployerId(ctx context.Context, employerId int, sortBy, sortOrder string, limit, offset int) (*[]model.EmployerJob, error)
+	CountJobsByEmployerId(ctx context.Context, employerId int) (int, error)
+	SearchSkills(ctx context.Context, prefix string, limit int) (*[]model.Skill, error)
+	ResolveSkillNames(ctx context.Context, names []string) ([]string, error)
+	GetTalentSkills(ctx context.Context, talentId int) ([]string, error)
+	UpdateTalentSkills(ctx context.Context, talentId int, skills []string) error
+	GetAppliedJobsByTalentId(ctx context.Context, talentId, limit int) (*[]model.Job, error)
+
+	GetApplicationsByJobId(ctx context.Context, jobId, employerId int, includeWithdrawn bool) (*[]model.Application, error)
 	GetApplicationByIdAndEmployeerId(ctx context.Context, applicationId, employerId int) (*model.Application, error)
 	GetApplicationByIdAndTalentId(ctx context.Context, applicationId, talentId int) (*model.Application, error)
-	InsertApplication(ctx context.Context, jobId, talentId int) error
-	UpdateApplicationStatus(ctx context.Context, applicationId, status int) error
+	GetApplicationsByTalentId(ctx context.Context, talentId int, statuses []int, limit, offset int) (*[]model.Application, error)
+	CountApplicationsByTalentId(ctx context.Context, talentId int, statuses []int) (int, error)
+	InsertApplication(ctx context.Context, application model.Application) (int, error)
+	HasAppliedToJob(ctx context.Context, jobId, talentId int) (bool, error)
+	GetApplicationAnswersByJobId(ctx context.Context, jobId int) (*[]model.ApplicationAnswer, error)
+	GetApplicationAnswersByApplicationId(ctx context.Context, applicationId int) (*[]model.ApplicationAnswer, error)
+	UpdateApplicationStatus(ctx context.Context, change model.ApplicationStatusChange) (bool, error)
+	GetApplicationStatusHistory(ctx context.Context, applicationId int) (*[]model.ApplicationStatusChange, error)
+	GetLastWithdrawDate(ctx context.Context, jobId, talentId int) (*time.Time, error)
+
+	InsertApplicationNote(ctx context.Context, note model.ApplicationNote) (*model.ApplicationNote, error)
+	GetApplicationNotes(ctx context.Context, applicationId int) (*[]model.ApplicationNote, error)
+	DeleteApplicationNote(ctx context.Context, noteId, applicationId, authorId int) error
+	UpdateApplicationTags(ctx context.Context, applicationId int, tags []string) error
+	GetApplicationTags(ctx context.Context, applicationId int) (*[]model.ApplicationTag, error)
+	GetApplicationTagsByJobId(ctx context.Context, jobId int) (*[]model.ApplicationTag, error)
+	GetScorecardCriteria(ctx context.Context, jobId int) (*[]model.ScorecardCriterion, error)
+	UpdateScorecardCriteria(ctx context.Context, jobId int, criteria []model.ScorecardCriterion) error
+	UpdateApplicationScores(ctx context.Context, applicationId, reviewerId int, scores []model.CriterionScore) error
+	GetApplicationScores(ctx context.Context, applicationId int) (*[]model.CriterionScore, error)
+	GetApplicationScoresByJobId(ctx context.Context, jobId int) (*[]model.CriterionScore, error)
+	InsertInterviewSlots(ctx context.Context, employerId int, slots []model.InterviewSlot) (bool, *[]model.InterviewSlot, error)
+	GetInterviewSlotsByJobId(ctx context.Context, jobId int) (*[]model.InterviewSlot, error)
+	GetAvailableInterviewSlots(ctx context.Context, jobId int, after time.Time) (*[]model.InterviewSlot, error)
+	GetInterviewSlotById(ctx context.Context, slotId int) (*model.InterviewSlot, error)
+	DeleteInterviewSlot(ctx context.Context, slotId, employerId int) (*model.Interview, error)
+	BookInterview(ctx context.Context, applicationId, talentId, slotId int) (bool, *model.Interview, error)
+	CancelInterview(ctx context.Context, applicationId int) (*model.Interview, error)
+	GetInterview(ctx context.Context, applicationId int) (*model.Interview, error)
+
+	InsertAttachment(ctx context.Context, attachment model.Attachment) (int, error)
+	GetAttachmentById(ctx context.Context, attachmentId int) (*model.Attachment, error)
+	GetAttachmentsByTalentId(ctx context.Context, talentId int) (*[]model.Attachment, error)
+	GetAttachmentsByIds(ctx context.Context, attachmentIds []int, talentId int) (*[]model.Attachment, error)
+	GetAttachmentsByApplicationId(ctx context.Context, applicationId int) (*[]model.Attachment, error)
+	GetAttachmentsByJobId(ctx context.Context, jobId int) (*[]model.Attachment, error)
+	IsAttachmentSharedWithEmployer(ctx context.Context, attachmentId, employerId int) (bool, error)
+
+	UpsertCompany(ctx context.Context, company model.Company) error
+	GetCompanyById(ctx context.Context, companyId int) (*model.Company, error)
+	GetCompanyByEmployerId(ctx context.Context, employerId int) (*model.Company, error)
+
+	UpsertTalentProfile(ctx context.Context, profile model.TalentProfile) error
+	GetTalentProfile(ctx context.Context, talentId int) (*model.TalentProfile, error)
+	DeleteTalentProfile(ctx context.Context, talentId int) error
+	HasAppliedToEmployer(ctx context.Context, talentId, employerId int) (bool, error)
+
+	InsertSavedJob(ctx context.Context, talentId, jobId int) error
+	DeleteSavedJob(ctx context.Context, talentId, jobId int) error
+	GetSavedJobs(ctx context.Context, talentId, limit, offset int) (*[]model.SavedJob, error)
+	CountSavedJobs(ctx context.Context, talentId int) (int, error)
+
+	InsertSavedSearch(ctx context.Context, savedSearch model.SavedSearch) error
+	GetSavedSearchesByTalentId(ctx context.Context, talentId int) (*[]model.SavedSearch, error)
+	GetSavedSearchesBySkills(ctx context.Context, skills []string, companyId *int) (*[]model.SavedSearch, error)
+	DeleteSavedSearch(ctx context.Context, savedSearchId, talentId int) error
+	InsertSavedSearchMatch(ctx context.Context, savedSearchId, jobId int) (bool, error)
+	GetPendingSavedSearchMatches(ctx context.Context) (*[]model.SavedSearchMatch, error)
+	UpdateSavedSearchMatchesNotified(ctx context.Context, matches []model.SavedSearchMatch) error
+
+	InsertJobImport(ctx context.Context, jobImport *model.JobImport) error
+	UpdateJobImport(ctx context.Context, jobImport model.JobImport) error
+	GetJobImportByIdAndEmployerId(ctx context.Context, importId, employerId int) (*model.JobImport, error)
 }
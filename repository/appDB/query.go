@@ -1,18 +1,176 @@
 package appDB
 
+import "github.com/michaelwongycn/job-portal/domain/enum"
+
 const (
 	getUserByEmailAndPasswordQuery = "SELECT id, role FROM users WHERE email = $1 AND password = $2"
 	insertUserQuery                = "INSERT INTO users (email, password, role) VALUES ($1, $2, $3) RETURNING id"
 	getUserTokenQuery              = "SELECT access_token, refresh_token, expiration_time FROM user_tokens WHERE user_id = $1"
 	insertUserTokenQuery           = "INSERT INTO user_tokens (user_id, access_token, refresh_token, expiration_time) VALUES ($1, $2, $3, $4) ON CONFLICT (user_id) DO UPDATE SET access_token = EXCLUDED.access_token, refresh_token = EXCLUDED.refresh_token, expiration_time = EXCLUDED.expiration_time"
 	deleteUserTokenQuery           = "DELETE FROM user_tokens WHERE user_id = $1"
+	getUserByIdQuery               = "SELECT id, email, role, is_verified FROM users WHERE id = $1"
+	verifyEmployerQuery            = "UPDATE users SET is_verified = TRUE WHERE id = $1 AND role = $2"
+
+	jobColumns                 = "j.id, j.employer_id, j.title, j.description, j.requirement, j.create_date, ARRAY(SELECT s.name FROM job_skills js JOIN skills s ON s.id = js.skill_id WHERE js.job_id = j.id ORDER BY s.name), (SELECT json_build_object('id', c.id, 'name', c.name, 'size', c.size, 'industry', c.industry, 'logo_path', c.logo_path) FROM companies c WHERE c.employer_id = j.employer_id), j.job_status, j.expire_date, j.openings, j.max_applications, j.moderation_status, j.city, j.latitude, j.longitude, j.language, j.required_fields"
+	getAllJobQuery             = "WITH wanted AS (SELECT DISTINCT COALESCE(sa.skill_id, s.id, -1) AS skill_id FROM UNNEST($1::text[]) AS w(name) LEFT JOIN skill_aliases sa ON sa.alias = w.name LEFT JOIN skills s ON s.name = w.name) SELECT " + jobColumns + " FROM jobs j WHERE (COALESCE(CARDINALITY($1::text[]), 0) = 0 OR j.id IN (SELECT job_id FROM job_skills WHERE skill_id IN (SELECT skill_id FROM wanted) GROUP BY job_id HAVING COUNT(*) = (SELECT COUNT(*) FROM wanted))) AND ($2 = 0 OR j.employer_id = $2) AND (NOT $3 OR (j.job_status = 1 AND j.moderation_status = 1 AND (j.expire_date IS NULL OR j.expire_date > NOW() AT TIME ZONE 'UTC'))) AND ($4::float8 IS NULL OR (j.latitude BETWEEN $4 AND $5::float8 AND CASE WHEN $6::float8 <= $7::float8 THEN j.longitude BETWEEN $6 AND $7 ELSE j.longitude >= $6 OR j.longitude <= $7 END)) ORDER BY j.id"
+	getJobByIdQuery            = "SELECT " + jobColumns + " FROM jobs j WHERE j.id = $1"
+	insertJobQuery             = "INSERT INTO jobs (employer_id, title, description, requirement, expire_date, openings, max_applications, moderation_status, city, latitude, longitude, language, required_fields) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13) RETURNING id"
+	updateJobStatusQuery       = "UPDATE jobs SET job_status = $1, auto_closed = FALSE WHERE id = $2 AND employer_id = $3"
+	getJobsByEmployerIdQuery   = "SELECT " + jobColumns + ", COUNT(a.id) FILTER (WHERE a.application_status = 1) AS created_count, COUNT(a.id) FILTER (WHERE a.application_status = 2) AS interview_count, COUNT(a.id) FILTER (WHERE a.application_status = 3) AS accepted_count, COUNT(a.id) FILTER (WHERE a.application_status = 4) AS declined_count, COUNT(a.id) FILTER (WHERE a.application_status = 5) AS withdrawn_count, COUNT(a.id) FILTER (WHERE a.application_status <> 5) AS total_count FROM jobs j LEFT JOIN applications a ON a.job_id = j.id WHERE j.employer_id = $1 GROUP BY j.id ORDER BY %s %s, j.id %s LIMIT $2 OFFSET $3"
+	countJobsByEmployerIdQuery = "SELECT COUNT(*) FROM jobs WHERE employer_id = $1"
 
-	getAllJobQuery                       = "SELECT * FROM jobs"
-	getJobByIdQuery                      = "SELECT * FROM jobs WHERE id = $1"
-	insertJobQuery                       = "INSERT INTO jobs (employer_id, title, description, requirement) VALUES ($1, $2, $3, $4)"
-	getApplicationsByJobIdQuery          = "SELECT a.* FROM applications a JOIN jobs j ON a.job_id = j.id WHERE j.id = $1 AND employer_id = $2"
-	getApplicationByIdAndEmployerIdQuery = "SELECT a.* FROM applications a JOIN jobs j ON a.job_id = j.id WHERE a.id = $1 AND employer_id = $2"
-	getApplicationByIdAndTalentIdQuery   = "SELECT * FROM applications WHERE id = $1 AND talent_id = $2"
-	insertApplicationQuery               = "INSERT INTO applications (job_id, talent_id) VALUES ($1, $2)"
+	getAppliedJobsByTalentIdQuery        = "SELECT " + jobColumns + " FROM applications a JOIN jobs j ON j.id = a.job_id WHERE a.talent_id = $1 ORDER BY a.apply_date DESC LIMIT $2"
+	applicationColumns                   = "a.id, a.job_id, a.talent_id, a.application_status, a.apply_date, a.job_revision_id, a.cover_letter, a.expected_salary, a.available_from, a.links, a.profile_snapshot"
+	getApplicationsByJobIdQuery          = "SELECT " + applicationColumns + " FROM applications a JOIN jobs j ON a.job_id = j.id WHERE j.id = $1 AND employer_id = $2 AND ($3 OR a.application_status <> 5)"
+	getApplicationByIdAndEmployerIdQuery = "SELECT " + applicationColumns + " FROM applications a JOIN jobs j ON a.job_id = j.id WHERE a.id = $1 AND employer_id = $2"
+	getApplicationByIdAndTalentIdQuery   = "SELECT " + applicationColumns + " FROM applications a WHERE a.id = $1 AND a.talent_id = $2"
+	getApplicationsByTalentIdQuery       = "SELECT " + applicationColumns + ", j.id, j.title, (SELECT json_build_object('id', c.id, 'name', c.name, 'size', c.size, 'industry', c.industry, 'logo_path', c.logo_path) FROM companies c WHERE c.employer_id = j.employer_id), j.city, j.job_status, j.expire_date FROM applications a JOIN jobs j ON j.id = a.job_id WHERE a.talent_id = $1 AND (COALESCE(CARDINALITY($2::int[]), 0) = 0 OR a.application_status = ANY($2)) ORDER BY a.apply_date DESC, a.id DESC LIMIT $3 OFFSET $4"
+	countApplicationsByTalentIdQuery     = "SELECT COUNT(*) FROM applications a WHERE a.talent_id = $1 AND (COALESCE(CARDINALITY($2::int[]), 0) = 0 OR a.application_status = ANY($2))"
+	insertApplicationQuery               = "INSERT INTO applications (job_id, talent_id, application_status, job_revision_id, cover_letter, expected_salary, available_from, links, profile_snapshot) VALUES ($1, $2, $3, (SELECT id FROM job_revisions WHERE job_id = $1 ORDER BY revision DESC LIMIT 1), $4, $5, $6, $7, $8) RETURNING id"
 	updateApplicationStatusQuery         = "UPDATE applications SET application_status = $1 WHERE id = $2"
+	lockApplicationJobQuery              = "SELECT j.id, a.application_status FROM jobs j JOIN applications a ON a.job_id = j.id WHERE a.id = $1 FOR UPDATE OF j, a"
+	lockJobForApplicationQuery           = "SELECT j.job_status, j.max_applications, (SELECT COUNT(*) FROM applications a WHERE a.job_id = j.id AND a.application_status <> 5) FROM jobs j WHERE j.id = $1 FOR UPDATE"
+	closeJobQuery                        = "UPDATE jobs SET job_status = 2, auto_closed = TRUE WHERE id = $1"
+	reopenJobQuery                       = "UPDATE jobs j SET job_status = 1, auto_closed = FALSE WHERE j.id = $1 AND j.job_status = 2 AND j.auto_closed AND (j.expire_date IS NULL OR j.expire_date > NOW() AT TIME ZONE 'UTC') AND (j.max_applications IS NULL OR (SELECT COUNT(*) FROM applications a WHERE a.job_id = j.id AND a.application_status <> 5) < j.max_applications) AND (j.openings IS NULL OR (SELECT COUNT(*) FROM applications a WHERE a.job_id = j.id AND a.application_status = 3) < j.openings)"
+	closeFilledJobQuery                  = "UPDATE jobs j SET job_status = 2, auto_closed = TRUE WHERE j.id = $1 AND j.openings IS NOT NULL AND (SELECT COUNT(*) FROM applications a WHERE a.job_id = j.id AND a.application_status = 3) >= j.openings"
+
+	insertApplicationStatusHistoryQuery = "INSERT INTO application_status_history (application_id, from_status, to_status, actor_id, note) VALUES ($1, $2, $3, $4, $5)"
+	getApplicationStatusHistoryQuery    = "SELECT id, application_id, from_status, to_status, actor_id, note, change_date FROM application_status_history WHERE application_id = $1 ORDER BY change_date, id"
+	getLastWithdrawDateQuery            = "SELECT MAX(h.change_date) FROM application_status_history h JOIN applications a ON a.id = h.application_id WHERE a.job_id = $1 AND a.talent_id = $2 AND h.to_status = 5"
+
+	insertApplicationNoteQuery     = "INSERT INTO application_notes (application_id, author_id, body) VALUES ($1, $2, $3) RETURNING id, create_date"
+	getApplicationNotesQuery       = "SELECT id, application_id, author_id, body, create_date FROM application_notes WHERE application_id = $1 ORDER BY create_date, id"
+	deleteApplicationNoteQuery     = "DELETE FROM application_notes WHERE id = $1 AND application_id = $2 AND author_id = $3"
+	deleteApplicationTagsQuery     = "DELETE FROM application_tags WHERE application_id = $1"
+	insertApplicationTagQuery      = "INSERT INTO application_tags (application_id, tag) VALUES ($1, $2) ON CONFLICT DO NOTHING"
+	getApplicationTagsQuery        = "SELECT application_id, tag FROM application_tags WHERE application_id = $1 ORDER BY tag"
+	getApplicationTagsByJobIdQuery = "SELECT t.application_id, t.tag FROM application_tags t JOIN applications a ON a.id = t.application_id WHERE a.job_id = $1 ORDER BY t.application_id, t.tag"
+
+	getScorecardCriteriaQuery        = "SELECT id, job_id, position, name, weight FROM scorecard_criteria WHERE job_id = $1 ORDER BY position"
+	deleteScorecardCriteriaQuery     = "DELETE FROM scorecard_criteria WHERE job_id = $1 AND NOT (name = ANY($2))"
+	upsertScorecardCriterionQuery    = "INSERT INTO scorecard_criteria (job_id, position, name, weight) VALUES ($1, $2, $3, $4) ON CONFLICT (job_id, name) DO UPDATE SET position = EXCLUDED.position, weight = EXCLUDED.weight"
+	deleteApplicationScoresQuery     = "DELETE FROM application_scores WHERE application_id = $1 AND reviewer_id = $2"
+	insertApplicationScoreQuery      = "INSERT INTO application_scores (application_id, criterion_id, reviewer_id, score) VALUES ($1, $2, $3, $4)"
+	criterionScoreColumns            = "s.application_id, s.reviewer_id, s.criterion_id, c.name, c.weight, s.score"
+	getApplicationScoresQuery        = "SELECT " + criterionScoreColumns + " FROM application_scores s JOIN scorecard_criteria c ON c.id = s.criterion_id WHERE s.application_id = $1 ORDER BY s.reviewer_id, c.position"
+	getApplicationScoresByJobIdQuery = "SELECT " + criterionScoreColumns + " FROM application_scores s JOIN scorecard_criteria c ON c.id = s.criterion_id WHERE c.job_id = $1 ORDER BY s.application_id, s.reviewer_id, c.position"
+
+	lockUserQuery                       = "SELECT id FROM users WHERE id = $1 FOR UPDATE"
+	countOverlappingInterviewSlotsQuery = "SELECT COUNT(*) FROM interview_slots WHERE employer_id = $1 AND start_date < $3 AND end_date > $2"
+	insertInterviewSlotQuery            = "INSERT INTO interview_slots (job_id, employer_id, start_date, end_date, location) VALUES ($1, $2, $3, $4, $5) RETURNING id, create_date"
+	interviewSlotColumns                = "s.id, s.job_id, s.employer_id, s.start_date, s.end_date, s.location, i.application_id, s.create_date"
+	getInterviewSlotsByJobIdQuery       = "SELECT " + interviewSlotColumns + " FROM interview_slots s LEFT JOIN interviews i ON i.slot_id = s.id AND i.interview_status = 1 WHERE s.job_id = $1 ORDER BY s.start_date, s.id"
+	getAvailableInterviewSlotsQuery     = "SELECT " + interviewSlotColumns + " FROM interview_slots s LEFT JOIN interviews i ON i.slot_id = s.id AND i.interview_status = 1 WHERE s.job_id = $1 AND s.start_date > $2 AND i.application_id IS NULL ORDER BY s.start_date, s.id"
+	getInterviewSlotByIdQuery           = "SELECT " + interviewSlotColumns + " FROM interview_slots s LEFT JOIN interviews i ON i.slot_id = s.id AND i.interview_status = 1 WHERE s.id = $1"
+	lockInterviewSlotQuery              = "SELECT id FROM interview_slots WHERE id = $1 AND employer_id = $2 FOR UPDATE"
+	lockInterviewSlotForBookingQuery    = "SELECT start_date, end_date FROM interview_slots WHERE id = $1 FOR UPDATE"
+	countInterviewSlotBookingsQuery     = "SELECT COUNT(*) FROM interviews WHERE slot_id = $1 AND interview_status = 1 AND application_id <> $2"
+	countTalentInterviewConflictsQuery  = "SELECT COUNT(*) FROM interviews i JOIN applications a ON a.id = i.application_id WHERE a.talent_id = $1 AND i.application_id <> $2 AND i.interview_status = 1 AND i.start_date < $4 AND i.end_date > $3"
+	deleteInterviewSlotQuery            = "DELETE FROM interview_slots WHERE id = $1"
+
+	interviewColumns             = "application_id, slot_id, start_date, end_date, location, interview_status, sequence, update_date"
+	getInterviewQuery            = "SELECT " + interviewColumns + " FROM interviews WHERE application_id = $1"
+	bookInterviewQuery           = "INSERT INTO interviews (application_id, slot_id, start_date, end_date, location, interview_status) SELECT $1, s.id, s.start_date, s.end_date, s.location, 1 FROM interview_slots s WHERE s.id = $2 ON CONFLICT (application_id) DO UPDATE SET slot_id = EXCLUDED.slot_id, start_date = EXCLUDED.start_date, end_date = EXCLUDED.end_date, location = EXCLUDED.location, interview_status = EXCLUDED.interview_status, sequence = interviews.sequence + 1, update_date = CURRENT_TIMESTAMP RETURNING " + interviewColumns
+	cancelInterviewQuery         = "UPDATE interviews SET interview_status = 2, sequence = sequence + 1, update_date = CURRENT_TIMESTAMP WHERE application_id = $1 AND interview_status = 1 RETURNING " + interviewColumns
+	cancelInterviewBySlotIdQuery = "UPDATE interviews SET interview_status = 2, sequence = sequence + 1, update_date = CURRENT_TIMESTAMP WHERE slot_id = $1 AND interview_status = 1 RETURNING " + interviewColumns
+
+	attachmentColumns                   = "at.id, at.talent_id, at.kind, at.file_name, at.content_type, at.size, at.storage_key, at.create_date"
+	insertAttachmentQuery               = "INSERT INTO attachments (talent_id, kind, file_name, content_type, size, storage_key) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id"
+	getAttachmentByIdQuery              = "SELECT " + attachmentColumns + " FROM attachments at WHERE at.id = $1"
+	getAttachmentsByTalentIdQuery       = "SELECT " + attachmentColumns + " FROM attachments at WHERE at.talent_id = $1 ORDER BY at.create_date DESC, at.id DESC"
+	getAttachmentsByIdsQuery            = "SELECT " + attachmentColumns + " FROM attachments at WHERE at.id = ANY($1) AND at.talent_id = $2 ORDER BY at.id"
+	getAttachmentsByApplicationIdQuery  = "SELECT aa.application_id, " + attachmentColumns + " FROM application_attachments aa JOIN attachments at ON at.id = aa.attachment_id WHERE aa.application_id = $1 ORDER BY at.id"
+	getAttachmentsByJobIdQuery          = "SELECT aa.application_id, " + attachmentColumns + " FROM application_attachments aa JOIN attachments at ON at.id = aa.attachment_id JOIN applications a ON a.id = aa.application_id WHERE a.job_id = $1 ORDER BY aa.application_id, at.id"
+	insertApplicationAttachmentQuery    = "INSERT INTO application_attachments (application_id, attachment_id) VALUES ($1, $2) ON CONFLICT DO NOTHING"
+	isAttachmentSharedWithEmployerQuery = "SELECT EXISTS (SELECT 1 FROM application_attachments aa JOIN applications a ON a.id = aa.application_id JOIN jobs j ON j.id = a.job_id WHERE aa.attachment_id = $1 AND j.employer_id = $2)"
+
+	getSkillIdByNameQuery   = "SELECT skill_id FROM skill_aliases WHERE alias = $1 UNION ALL SELECT id FROM skills WHERE name = $1 LIMIT 1"
+	insertSkillQuery        = "INSERT INTO skills (name) VALUES ($1) ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name RETURNING id"
+	insertJobSkillQuery     = "INSERT INTO job_skills (job_id, skill_id) VALUES ($1, $2) ON CONFLICT DO NOTHING"
+	resolveSkillNamesQuery  = "SELECT DISTINCT COALESCE(s.name, w.name) FROM UNNEST($1::text[]) AS w(name) LEFT JOIN skill_aliases sa ON sa.alias = w.name LEFT JOIN skills s ON s.id = sa.skill_id ORDER BY 1"
+	getTalentSkillsQuery    = "SELECT s.name FROM talent_skills ts JOIN skills s ON s.id = ts.skill_id WHERE ts.talent_id = $1 ORDER BY s.name"
+	deleteTalentSkillsQuery = "DELETE FROM talent_skills WHERE talent_id = $1"
+	insertTalentSkillQuery  = "INSERT INTO talent_skills (talent_id, skill_id) VALUES ($1, $2) ON CONFLICT DO NOTHING"
+	searchSkillsQuery       = "SELECT s.id, s.name, ARRAY(SELECT alias FROM skill_aliases WHERE skill_id = s.id ORDER BY alias) FROM skills s WHERE s.name LIKE $1 OR EXISTS (SELECT 1 FROM skill_aliases sa WHERE sa.skill_id = s.id AND sa.alias LIKE $1) ORDER BY (SELECT COUNT(*) FROM job_skills js WHERE js.skill_id = s.id) DESC, s.name LIMIT $2"
+
+	companyColumns              = "id, employer_id, name, website, description, size, industry, logo_path, create_date"
+	upsertCompanyQuery          = "INSERT INTO companies (employer_id, name, website, description, size, industry, logo_path) VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT (employer_id) DO UPDATE SET name = EXCLUDED.name, website = EXCLUDED.website, description = EXCLUDED.description, size = EXCLUDED.size, industry = EXCLUDED.industry, logo_path = EXCLUDED.logo_path"
+	getCompanyByIdQuery         = "SELECT " + companyColumns + " FROM companies WHERE id = $1"
+	getCompanyByEmployerIdQuery = "SELECT " + companyColumns + " FROM companies WHERE employer_id = $1"
+
+	upsertTalentProfileQuery     = "INSERT INTO talent_profiles (talent_id, headline, summary, links, visibility) VALUES ($1, $2, $3, $4, $5) ON CONFLICT (talent_id) DO UPDATE SET headline = EXCLUDED.headline, summary = EXCLUDED.summary, links = EXCLUDED.links, visibility = EXCLUDED.visibility, update_date = CURRENT_TIMESTAMP"
+	getTalentProfileQuery        = "SELECT p.talent_id, p.headline, p.summary, p.links, p.visibility, p.update_date, ARRAY(SELECT s.name FROM talent_skills ts JOIN skills s ON s.id = ts.skill_id WHERE ts.talent_id = p.talent_id ORDER BY s.name) FROM talent_profiles p WHERE p.talent_id = $1"
+	deleteTalentProfileQuery     = "DELETE FROM talent_profiles WHERE talent_id = $1"
+	deleteTalentExperiencesQuery = "DELETE FROM talent_experiences WHERE talent_id = $1"
+	insertTalentExperienceQuery  = "INSERT INTO talent_experiences (talent_id, position, title, company, start_date, end_date, description) VALUES ($1, $2, $3, $4, $5, $6, $7)"
+	getTalentExperiencesQuery    = "SELECT title, company, start_date, end_date, description FROM talent_experiences WHERE talent_id = $1 ORDER BY position"
+	deleteTalentEducationsQuery  = "DELETE FROM talent_educations WHERE talent_id = $1"
+	insertTalentEducationQuery   = "INSERT INTO talent_educations (talent_id, position, school, degree, field_of_study, start_date, end_date) VALUES ($1, $2, $3, $4, $5, $6, $7)"
+	getTalentEducationsQuery     = "SELECT school, degree, field_of_study, start_date, end_date FROM talent_educations WHERE talent_id = $1 ORDER BY position"
+	hasAppliedToEmployerQuery    = "SELECT EXISTS (SELECT 1 FROM applications a JOIN jobs j ON j.id = a.job_id WHERE a.talent_id = $1 AND j.employer_id = $2)"
+
+	insertSavedJobQuery = "INSERT INTO saved_jobs (talent_id, job_id) VALUES ($1, $2) ON CONFLICT DO NOTHING"
+	deleteSavedJobQuery = "DELETE FROM saved_jobs WHERE talent_id = $1 AND job_id = $2"
+	getSavedJobsQuery   = "SELECT " + jobColumns + ", sj.save_date FROM saved_jobs sj JOIN jobs j ON j.id = sj.job_id WHERE sj.talent_id = $1 AND j.moderation_status = 1 ORDER BY sj.save_date DESC, j.id DESC LIMIT $2 OFFSET $3"
+	countSavedJobsQuery = "SELECT COUNT(*) FROM saved_jobs sj JOIN jobs j ON j.id = sj.job_id WHERE sj.talent_id = $1 AND j.moderation_status = 1"
+
+	savedSearchColumns                  = "ss.id, ss.talent_id, u.email, ss.name, ss.keywords, ss.skills, ss.company_id, ss.frequency, ss.create_date"
+	insertSavedSearchQuery              = "INSERT INTO saved_searches (talent_id, name, keywords, skills, company_id, frequency) VALUES ($1, $2, $3, $4, $5, $6)"
+	getSavedSearchesByTalentIdQuery     = "SELECT " + savedSearchColumns + " FROM saved_searches ss JOIN users u ON u.id = ss.talent_id WHERE ss.talent_id = $1 ORDER BY ss.id"
+	deleteSavedSearchQuery              = "DELETE FROM saved_searches WHERE id = $1 AND talent_id = $2"
+	getSavedSearchesBySkillsQuery       = "SELECT " + savedSearchColumns + " FROM saved_searches ss JOIN users u ON u.id = ss.talent_id WHERE ss.skills <@ $1::text[] AND (ss.company_id IS NULL OR ss.company_id = $2)"
+	insertSavedSearchMatchQuery         = "INSERT INTO saved_search_matches (saved_search_id, job_id) VALUES ($1, $2) ON CONFLICT DO NOTHING"
+	getPendingSavedSearchMatchesQuery   = "SELECT " + savedSearchColumns + ", " + jobColumns + ", m.match_date FROM saved_search_matches m JOIN saved_searches ss ON ss.id = m.saved_search_id JOIN users u ON u.id = ss.talent_id JOIN jobs j ON j.id = m.job_id WHERE m.notify_date IS NULL ORDER BY ss.talent_id, m.match_date"
+	updateSavedSearchMatchNotifiedQuery = "UPDATE saved_search_matches SET notify_date = CURRENT_TIMESTAMP WHERE saved_search_id = $1 AND job_id = $2"
+
+	insertJobQuestionQuery                    = "INSERT INTO job_questions (job_id, position, question, question_type, options, knockout_answers, knockout_min, knockout_max) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)"
+	getJobQuestionsQuery                      = "SELECT id, job_id, position, question, question_type, options, knockout_answers, knockout_min, knockout_max FROM job_questions WHERE job_id = $1 ORDER BY position"
+	insertApplicationAnswerQuery              = "INSERT INTO application_answers (application_id, question_id, answer, knocked_out) VALUES ($1, $2, $3, $4)"
+	getApplicationAnswersByJobIdQuery         = "SELECT aa.application_id, aa.question_id, q.question, aa.answer, aa.knocked_out FROM application_answers aa JOIN job_questions q ON q.id = aa.question_id WHERE q.job_id = $1 ORDER BY aa.application_id, q.position"
+	getApplicationAnswersByApplicationIdQuery = "SELECT aa.application_id, aa.question_id, q.question, aa.answer, aa.knocked_out FROM application_answers aa JOIN job_questions q ON q.id = aa.question_id WHERE aa.application_id = $1 ORDER BY q.position"
+
+	jobImportColumns                   = "id, employer_id, import_status, mode, total_rows, inserted_rows, failed_rows, report, create_date, finish_date"
+	insertJobImportQuery               = "INSERT INTO job_imports (employer_id, import_status, mode, total_rows) VALUES ($1, $2, $3, $4) RETURNING id"
+	updateJobImportQuery               = "UPDATE job_imports SET import_status = $1, inserted_rows = $2, failed_rows = $3, report = $4, finish_date = $5 WHERE id = $6"
+	getJobImportByIdAndEmployerIdQuery = "SELECT " + jobImportColumns + " FROM job_imports WHERE id = $1 AND employer_id = $2"
+	savepointQuery                     = "SAVEPOINT insert_job"
+	rollbackToSavepointQuery           = "ROLLBACK TO SAVEPOINT insert_job"
+	releaseSavepointQuery              = "RELEASE SAVEPOINT insert_job"
+
+	jobRevisionColumns     = "r.id, r.job_id, r.revision, r.title, r.description, r.requirement, r.skills, r.expire_date, r.create_date"
+	updateJobQuery         = "UPDATE jobs SET title = $1, description = $2, requirement = $3, expire_date = $4, openings = $5, max_applications = $6, moderation_status = COALESCE(NULLIF($7, 0), moderation_status), city = $8, latitude = $9, longitude = $10, language = $11, required_fields = $12 WHERE id = $13 AND employer_id = $14"
+	deleteJobSkillsQuery   = "DELETE FROM job_skills WHERE job_id = $1"
+	insertJobRevisionQuery = "WITH snapshot AS (SELECT j.id, j.title, j.description, j.requirement, ARRAY(SELECT s.name FROM job_skills js JOIN skills s ON s.id = js.skill_id WHERE js.job_id = j.id ORDER BY s.name) AS skills, j.expire_date FROM jobs j WHERE j.id = $1), latest AS (SELECT * FROM job_revisions WHERE job_id = $1 ORDER BY revision DESC LIMIT 1) INSERT INTO job_revisions (job_id, revision, title, description, requirement, skills, expire_date) SELECT sn.id, COALESCE((SELECT revision FROM latest), 0) + 1, sn.title, sn.description, sn.requirement, sn.skills, sn.expire_date FROM snapshot sn WHERE NOT EXISTS (SELECT 1 FROM latest l WHERE l.title = sn.title AND l.description = sn.description AND l.requirement = sn.requirement AND l.skills = sn.skills AND l.expire_date IS NOT DISTINCT FROM sn.expire_date)"
+	getJobRevisionsQuery   = "SELECT " + jobRevisionColumns + " FROM job_revisions r WHERE r.job_id = $1 ORDER BY r.revision"
+	getJobRevisionQuery    = "SELECT " + jobRevisionColumns + " FROM job_revisions r WHERE r.job_id = $1 AND r.revision = $2"
+	hasAppliedToJobQuery   = "SELECT EXISTS (SELECT 1 FROM applications WHERE job_id = $1 AND talent_id = $2)"
+
+	getRecentJobsByEmployerIdQuery = "SELECT " + jobColumns + " FROM jobs j WHERE j.employer_id = $1 AND j.create_date >= NOW() - make_interval(days => $2) ORDER BY j.id DESC"
+	insertJobModerationQuery       = "INSERT INTO job_moderations (job_id, flags) VALUES ($1, $2)"
+
+	insertJobTranslationQuery  = "INSERT INTO job_translations (job_id, language, title, description, requirement) VALUES ($1, $2, $3, $4, $5)"
+	deleteJobTranslationsQuery = "DELETE FROM job_translations WHERE job_id = $1"
+	getJobTranslationsQuery    = "SELECT job_id, language, title, description, requirement FROM job_translations WHERE job_id = ANY($1) ORDER BY job_id, language"
+
+	getModerationJobsQuery   = "SELECT " + jobColumns + ", ARRAY(SELECT DISTINCT f FROM job_moderations m, UNNEST(m.flags) AS f WHERE m.job_id = j.id AND m.review_date IS NULL ORDER BY f), COALESCE((SELECT MIN(m.create_date) FROM job_moderations m WHERE m.job_id = j.id AND m.review_date IS NULL), j.create_date) AS queue_date FROM jobs j WHERE j.moderation_status = 2 ORDER BY queue_date, j.id LIMIT $1 OFFSET $2"
+	countModerationJobsQuery = "SELECT COUNT(*) FROM jobs WHERE moderation_status = 2"
+	reviewJobQuery           = "UPDATE jobs SET moderation_status = $1 WHERE id = $2 AND moderation_status = 2"
+	reviewJobModerationQuery = "UPDATE job_moderations SET review_date = CURRENT_TIMESTAMP, reviewer_id = $1, decision = $2, reason = NULLIF($3, '') WHERE job_id = $4 AND review_date IS NULL"
+
+	jobReportColumns         = "r.id, r.job_id, j.title, r.talent_id, r.category, r.details, r.report_status, r.create_date, r.review_date, r.reviewer_id, r.resolution"
+	insertJobReportQuery     = "INSERT INTO job_reports (job_id, talent_id, category, details) VALUES ($1, $2, $3, $4) ON CONFLICT (job_id, talent_id) DO NOTHING"
+	countOpenJobReportsQuery = "SELECT COUNT(*) FROM job_reports WHERE job_id = $1 AND report_status = 1"
+	hideReportedJobQuery     = "UPDATE jobs SET moderation_status = 2 WHERE id = $1 AND moderation_status = 1"
+	getJobReportsQuery       = "SELECT " + jobReportColumns + " FROM job_reports r JOIN jobs j ON j.id = r.job_id WHERE ($1 = 0 OR r.report_status = $1) ORDER BY r.create_date, r.id LIMIT $2 OFFSET $3"
+	countJobReportsQuery     = "SELECT COUNT(*) FROM job_reports WHERE ($1 = 0 OR report_status = $1)"
+	resolveJobReportQuery    = "UPDATE job_reports SET report_status = $1, review_date = CURRENT_TIMESTAMP, reviewer_id = $2, resolution = NULLIF($3, '') WHERE id = $4 AND report_status = 1 RETURNING job_id"
+	upholdJobReportsQuery    = "UPDATE job_reports SET report_status = 3, review_date = CURRENT_TIMESTAMP, reviewer_id = $1, resolution = NULLIF($2, '') WHERE job_id = $3 AND report_status = 1"
+	rejectReportedJobQuery   = "UPDATE jobs SET moderation_status = 3 WHERE id = $1"
+	closeJobModerationsQuery = "UPDATE job_moderations SET review_date = CURRENT_TIMESTAMP, reviewer_id = $1, decision = 3, reason = NULLIF($2, '') WHERE job_id = $3 AND review_date IS NULL"
 )
+
+var employerJobSortColumns = map[string]string{
+	enum.SortByCreateDate:   "j.create_date",
+	enum.SortByTitle:        "j.title",
+	enum.SortByApplications: "total_count",
+}
@@ -0,0 +1,286 @@
+package attachment
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/michaelwongycn/job-portal/domain/config"
+	"github.com/michaelwongycn/job-portal/domain/enum"
+	"github.com/michaelwongycn/job-portal/domain/model"
+	"github.com/michaelwongycn/job-portal/domain/request"
+	"github.com/michaelwongycn/job-portal/lib/log"
+	"github.com/michaelwongycn/job-portal/lib/scanner"
+	"github.com/michaelwongycn/job-portal/lib/storage"
+	"github.com/michaelwongycn/job-portal/repository/appDB"
+)
+
+const (
+	defaultMaxUploadSize = 10 << 20
+	defaultURLExpiry     = 15 * time.Minute
+	maxFileNameLength    = 255
+
+	storageCleanupErrorMsg = "error when removing orphaned upload"
+)
+
+var (
+	ErrInvalidAttachment   = errors.New("Invalid Attachment")
+	ErrFileTooLarge        = errors.New("File Too Large")
+	ErrUnsupportedFileType = errors.New("Unsupported File Type")
+	ErrInfectedFile        = errors.New("Infected File")
+	ErrInvalidSignature    = errors.New("Invalid Signature")
+	ErrExpiredLink         = errors.New("Expired Link")
+)
+
+// fileType is an accepted upload format. The extension decides the stored
+// content type, and the content sniffed from the file has to agree with it,
+// so a renamed executable isn't served as a PDF.
+type fileType struct {
+	contentType string
+	sniffed     []string
+}
+
+var fileTypes = map[string]fileType{
+	".pdf":  {"application/pdf", []string{"application/pdf"}},
+	".docx": {"application/vnd.openxmlformats-officedocument.wordprocessingml.document", []string{"application/zip"}},
+	".txt":  {"text/plain; charset=utf-8", []string{"text/plain"}},
+	".png":  {"image/png", []string{"image/png"}},
+	".jpg":  {"image/jpeg", []string{"image/jpeg"}},
+	".jpeg": {"image/jpeg", []string{"image/jpeg"}},
+	".zip":  {"application/zip", []string{"application/zip"}},
+}
+
+// kindExtensions lists the extensions accepted for every attachment kind.
+var kindExtensions = map[int][]string{
+	enum.ResumeAttachment:    {".pdf", ".docx", ".txt"},
+	enum.PortfolioAttachment: {".pdf", ".docx", ".txt", ".png", ".jpg", ".jpeg", ".zip"},
+}
+
+type attachmentImpl struct {
+	appDB         appDB.AppDBInterface
+	storage       storage.Storage
+	scanner       scanner.Scanner
+	baseURL       string
+	signingKey    []byte
+	urlExpiry     time.Duration
+	maxUploadSize int64
+}
+
+// NewAttachmentImpl builds the attachment usecase. Without a configured
+// signing key a random one is generated, so download links stop working when
+// the service restarts.
+func NewAttachmentImpl(appDB appDB.AppDBInterface, storage storage.Storage, scanner scanner.Scanner, cfg config.StorageConfig) AttachmentUsecase {
+	signingKey := []byte(cfg.SigningKey)
+	if len(signingKey) == 0 {
+		signingKey = make([]byte, 32)
+		rand.Read(signingKey)
+	}
+
+	urlExpiry := cfg.URLExpiry * time.Minute
+	if urlExpiry <= 0 {
+		urlExpiry = defaultURLExpiry
+	}
+
+	maxUploadSize := cfg.MaxUploadSize
+	if maxUploadSize <= 0 {
+		maxUploadSize = defaultMaxUploadSize
+	}
+
+	return &attachmentImpl{
+		appDB:         appDB,
+		storage:       storage,
+		scanner:       scanner,
+		baseURL:       strings.TrimSuffix(cfg.BaseURL, "/"),
+		signingKey:    signingKey,
+		urlExpiry:     urlExpiry,
+		maxUploadSize: maxUploadSize,
+	}
+}
+
+// MaxUploadSize is the largest file, in bytes, UploadAttachment accepts.
+func (u *attachmentImpl) MaxUploadSize() int64 {
+	return u.maxUploadSize
+}
+
+// UploadAttachment validates, scans and stores a file for the talent. The
+// object is removed again when its row can't be inserted.
+func (u *attachmentImpl) UploadAttachment(ctx context.Context, req request.UploadAttachmentRequest) (*model.Attachment, error) {
+	extensions, ok := kindExtensions[req.Kind]
+	if !ok {
+		return nil, ErrInvalidAttachment
+	}
+
+	fileName := cleanFileName(req.FileName)
+	if fileName == "" {
+		return nil, ErrInvalidAttachment
+	}
+
+	if len(req.Data) == 0 || int64(len(req.Data)) > u.maxUploadSize {
+		return nil, ErrFileTooLarge
+	}
+
+	extension := strings.ToLower(path.Ext(fileName))
+	fileType, ok := fileTypes[extension]
+	if !ok || !contains(extensions, extension) {
+		return nil, ErrUnsupportedFileType
+	}
+	sniffed, _, _ := mime.ParseMediaType(http.DetectContentType(req.Data))
+	if !contains(fileType.sniffed, sniffed) {
+		return nil, ErrUnsupportedFileType
+	}
+
+	err := u.scanner.Scan(ctx, fileName, req.Data)
+	if err != nil {
+		if errors.Is(err, scanner.ErrInfected) {
+			return nil, ErrInfectedFile
+		}
+		return nil, err
+	}
+
+	suffix := make([]byte, 16)
+	_, err = rand.Read(suffix)
+	if err != nil {
+		return nil, err
+	}
+	key := fmt.Sprintf("attachments/%d/%s%s", req.TalentId, hex.EncodeToString(suffix), extension)
+
+	err = u.storage.Put(ctx, key, req.Data, fileType.contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	attachmentId, err := u.appDB.InsertAttachment(ctx, model.Attachment{
+		TalentId:    req.TalentId,
+		Kind:        req.Kind,
+		FileName:    fileName,
+		ContentType: fileType.contentType,
+		Size:        int64(len(req.Data)),
+		StorageKey:  key,
+	})
+	if err != nil {
+		if deleteErr := u.storage.Delete(context.Background(), key); deleteErr != nil {
+			log.PrintLogErr(ctx, storageCleanupErrorMsg, deleteErr)
+		}
+		return nil, err
+	}
+
+	return u.appDB.GetAttachmentById(ctx, attachmentId)
+}
+
+func (u *attachmentImpl) GetAttachments(ctx context.Context, req request.SearchAttachmentRequest) (*[]model.Attachment, error) {
+	return u.appDB.GetAttachmentsByTalentId(ctx, req.TalentId)
+}
+
+// GetAttachmentURL signs a short lived download link for the talent owning
+// the attachment or an employer it was sent to with an application. Anyone
+// else gets sql.ErrNoRows, so attachment ids can't be probed.
+func (u *attachmentImpl) GetAttachmentURL(ctx context.Context, req request.SearchAttachmentURLRequest) (*model.AttachmentURL, error) {
+	attachment, err := u.appDB.GetAttachmentById(ctx, req.AttachmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	switch req.Role {
+	case enum.TalentRole:
+		if attachment.TalentId != req.UserId {
+			return nil, sql.ErrNoRows
+		}
+	case enum.EmployerRole:
+		shared, err := u.appDB.IsAttachmentSharedWithEmployer(ctx, attachment.ID, req.UserId)
+		if err != nil {
+			return nil, err
+		}
+		if !shared {
+			return nil, sql.ErrNoRows
+		}
+	default:
+		return nil, sql.ErrNoRows
+	}
+
+	expireDate := time.Now().Add(u.urlExpiry).Truncate(time.Second)
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expireDate.Unix(), 10))
+	query.Set("signature", u.sign(attachment.ID, expireDate.Unix()))
+
+	return &model.AttachmentURL{
+		URL:        fmt.Sprintf("%s/attachment/%d/download?%s", u.baseURL, attachment.ID, query.Encode()),
+		ExpireDate: expireDate,
+	}, nil
+}
+
+// DownloadAttachment checks a signed link and opens the attachment. The
+// caller closes the returned reader.
+func (u *attachmentImpl) DownloadAttachment(ctx context.Context, req request.DownloadAttachmentRequest) (*model.Attachment, io.ReadCloser, error) {
+	signature, err := hex.DecodeString(req.Signature)
+	if err != nil || !hmac.Equal(signature, u.mac(req.AttachmentId, req.Expires)) {
+		return nil, nil, ErrInvalidSignature
+	}
+	if time.Now().Unix() > req.Expires {
+		return nil, nil, ErrExpiredLink
+	}
+
+	attachment, err := u.appDB.GetAttachmentById(ctx, req.AttachmentId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content, err := u.storage.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		if err == storage.ErrNotFound {
+			return nil, nil, sql.ErrNoRows
+		}
+		return nil, nil, err
+	}
+	return attachment, content, nil
+}
+
+func (u *attachmentImpl) sign(attachmentId int, expires int64) string {
+	return hex.EncodeToString(u.mac(attachmentId, expires))
+}
+
+func (u *attachmentImpl) mac(attachmentId int, expires int64) []byte {
+	mac := hmac.New(sha256.New, u.signingKey)
+	fmt.Fprintf(mac, "%d:%d", attachmentId, expires)
+	return mac.Sum(nil)
+}
+
+// cleanFileName keeps the base name of an uploaded file, dropping any
+// directories the client sent along and control characters. It returns an
+// empty string when nothing usable is left.
+func cleanFileName(name string) string {
+	name = path.Base(strings.ReplaceAll(name, "\\", "/"))
+	name = strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f {
+			return -1
+		}
+		return r
+	}, name)
+	name = strings.TrimSpace(name)
+	if name == "" || name == "." || name == "/" || !utf8.ValidString(name) || len(name) > maxFileNameLength {
+		return ""
+	}
+	return name
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,17 @@
+package attachment
+
+import (
+	"context"
+	"io"
+
+	"github.com/michaelwongycn/job-portal/domain/model"
+	"github.com/michaelwongycn/job-portal/domain/request"
+)
+
+type AttachmentUsecase interface {
+	UploadAttachment(ctx context.Context, req request.UploadAttachmentRequest) (*model.Attachment, error)
+	GetAttachments(ctx context.Context, req request.SearchAttachmentRequest) (*[]model.Attachment, error)
+	GetAttachmentURL(ctx context.Context, req request.SearchAttachmentURLRequest) (*model.AttachmentURL, error)
+	DownloadAttachment(ctx context.Context, req request.DownloadAttachmentRequest) (*model.Attachment, io.ReadCloser, error)
+	MaxUploadSize() int64
+}
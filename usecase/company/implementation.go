@@ -0,0 +1,115 @@
+package company
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+
+	"github.com/michaelwongycn/job-portal/domain/enum"
+	"github.com/michaelwongycn/job-portal/domain/model"
+	"github.com/michaelwongycn/job-portal/domain/request"
+	"github.com/michaelwongycn/job-portal/repository/appDB"
+)
+
+const (
+	maxNameLength     = 255
+	maxWebsiteLength  = 255
+	maxIndustryLength = 100
+	maxLogoPathLength = 255
+)
+
+var (
+	ErrInvalidCompany = errors.New("Invalid Company")
+)
+
+var companySizes = []string{
+	enum.CompanySizeMicro,
+	enum.CompanySizeSmall,
+	enum.CompanySizeMedium,
+	enum.CompanySizeLarge,
+	enum.CompanySizeVeryLarge,
+	enum.CompanySizeEnterprise,
+}
+
+type companyImpl struct {
+	appDB appDB.AppDBInterface
+}
+
+func NewCompanyImpl(appDB appDB.AppDBInterface) CompanyUsecase {
+	return &companyImpl{
+		appDB: appDB,
+	}
+}
+
+func (u *companyImpl) UpsertCompany(ctx context.Context, req request.UpsertCompanyRequest) error {
+	company := model.Company{
+		EmployerId:  req.EmployerId,
+		Name:        strings.TrimSpace(req.Name),
+		Website:     strings.TrimSpace(req.Website),
+		Description: strings.TrimSpace(req.Description),
+		Size:        strings.TrimSpace(req.Size),
+		Industry:    strings.TrimSpace(req.Industry),
+		LogoPath:    strings.TrimSpace(req.LogoPath),
+	}
+
+	if err := validateCompany(company); err != nil {
+		return err
+	}
+	return u.appDB.UpsertCompany(ctx, company)
+}
+
+func (u *companyImpl) GetCompanyByEmployerId(ctx context.Context, req request.SearchCompanyByEmployerRequest) (*model.Company, error) {
+	return u.appDB.GetCompanyByEmployerId(ctx, req.EmployerId)
+}
+
+func (u *companyImpl) GetCompanyPage(ctx context.Context, req request.SearchCompanyByIdRequest) (*model.CompanyPage, error) {
+	company, err := u.appDB.GetCompanyById(ctx, req.CompanyId)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs, err := u.appDB.GetAllJob(ctx, model.JobFilter{EmployerId: company.EmployerId, OpenOnly: true})
+	if err != nil {
+		return nil, err
+	}
+
+	page := model.CompanyPage{
+		Company: *company,
+		Jobs:    []model.Job{},
+	}
+	if jobs != nil {
+		page.Jobs = *jobs
+	}
+	return &page, nil
+}
+
+func validateCompany(company model.Company) error {
+	if company.Name == "" || len(company.Name) > maxNameLength {
+		return ErrInvalidCompany
+	}
+	if len(company.Industry) > maxIndustryLength || len(company.LogoPath) > maxLogoPathLength {
+		return ErrInvalidCompany
+	}
+
+	if company.Website != "" {
+		website, err := url.Parse(company.Website)
+		if err != nil || (website.Scheme != "http" && website.Scheme != "https") || website.Host == "" || len(company.Website) > maxWebsiteLength {
+			return ErrInvalidCompany
+		}
+	}
+
+	if company.Size != "" {
+		valid := false
+		for _, size := range companySizes {
+			if company.Size == size {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return ErrInvalidCompany
+		}
+	}
+	return nil
+}
@@ -0,0 +1,14 @@
+package company
+
+import (
+	"context"
+
+	"github.com/michaelwongycn/job-portal/domain/model"
+	"github.com/michaelwongycn/job-portal/domain/request"
+)
+
+type CompanyUsecase interface {
+	UpsertCompany(ctx context.Context, req request.UpsertCompanyRequest) error
+	GetCompanyByEmployerId(ctx context.Context, req request.SearchCompanyByEmployerRequest) (*model.Company, error)
+	GetCompanyPage(ctx context.Context, req request.SearchCompanyByIdRequest) (*model.CompanyPage, error)
+}
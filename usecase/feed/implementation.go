@@ -0,0 +1,79 @@
+package feed
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/michaelwongycn/job-portal/domain/config"
+	"github.com/michaelwongycn/job-portal/domain/model"
+	"github.com/michaelwongycn/job-portal/domain/request"
+	"github.com/michaelwongycn/job-portal/lib/feed"
+	"github.com/michaelwongycn/job-portal/repository/appDB"
+)
+
+const (
+	defaultMaxItems = 100
+)
+
+var (
+	ErrInvalidFeedFormat = errors.New("Invalid Feed Format")
+)
+
+type feedImpl struct {
+	appDB    appDB.AppDBInterface
+	channel  feed.Channel
+	maxItems int
+}
+
+func NewFeedImpl(appDB appDB.AppDBInterface, cfg config.FeedConfig) FeedUsecase {
+	maxItems := cfg.MaxItems
+	if maxItems <= 0 {
+		maxItems = defaultMaxItems
+	}
+
+	return &feedImpl{
+		appDB: appDB,
+		channel: feed.Channel{
+			Title:       cfg.Title,
+			Link:        strings.TrimSuffix(cfg.BaseURL, "/"),
+			Description: cfg.Description,
+		},
+		maxItems: maxItems,
+	}
+}
+
+func (u *feedImpl) GetJobFeed(ctx context.Context, req request.SearchJobFeedRequest) (*model.JobFeed, error) {
+	contentType := feed.ContentType(req.Format)
+	if contentType == "" {
+		return nil, ErrInvalidFeedFormat
+	}
+
+	jobs, err := u.appDB.GetAllJob(ctx, model.JobFilter{OpenOnly: true})
+	if err != nil {
+		return nil, err
+	}
+
+	items := *jobs
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].CreateDate.After(items[j].CreateDate)
+	})
+	if len(items) > u.maxItems {
+		items = items[:u.maxItems]
+	}
+
+	body, err := feed.Render(req.Format, u.channel, items)
+	if err != nil {
+		return nil, err
+	}
+
+	sum := sha256.Sum256(body)
+	return &model.JobFeed{
+		Body:        body,
+		ContentType: contentType,
+		ETag:        `"` + hex.EncodeToString(sum[:16]) + `"`,
+	}, nil
+}
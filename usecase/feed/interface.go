@@ -0,0 +1,12 @@
+package feed
+
+import (
+	"context"
+
+	"github.com/michaelwongycn/job-portal/domain/model"
+	"github.com/michaelwongycn/job-portal/domain/request"
+)
+
+type FeedUsecase interface {
+	GetJobFeed(ctx context.Context, req request.SearchJobFeedRequest) (*model.JobFeed, error)
+}
@@ -1,72 +1,2722 @@
 package job
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
+	"unicode"
 
+	"github.com/michaelwongycn/job-portal/domain/config"
 	"github.com/michaelwongycn/job-portal/domain/enum"
 	"github.com/michaelwongycn/job-portal/domain/model"
 	"github.com/michaelwongycn/job-portal/domain/request"
+	"github.com/michaelwongycn/job-portal/lib/diff"
+	"github.com/michaelwongycn/job-portal/lib/geo"
+	"github.com/michaelwongycn/job-portal/lib/ical"
+	"github.com/michaelwongycn/job-portal/lib/locale"
+	"github.com/michaelwongycn/job-portal/lib/log"
+	"github.com/michaelwongycn/job-portal/lib/markdown"
+	"github.com/michaelwongycn/job-portal/lib/notifier"
+	"github.com/michaelwongycn/job-portal/lib/text"
 	"github.com/michaelwongycn/job-portal/repository/appDB"
 )
 
+const (
+	maxSkillLength   = 100
+	maxSkillsPerJob  = 30
+	maxSkillsResults = 20
+
+	maxSavedSearchNameLength     = 255
+	maxSavedSearchKeywordsLength = 255
+
+	savedSearchNotifyErrorMsg = "error when notifying saved search matches"
+
+	maxSkillsPerTalent       = 50
+	maxRecommendationHistory = 50
+	maxRecommendedJobs       = 20
+	maxRecommendationTerms   = 5
+	skillOverlapWeight       = 0.6
+	textSimilarityWeight     = 0.4
+
+	maxQuestionsPerJob    = 20
+	maxQuestionLength     = 500
+	maxQuestionOptions    = 20
+	maxOptionLength       = 255
+	maxFreeTextAnswerSize = 2000
+
+	maxImportRows             = 1000
+	backgroundImportThreshold = 50
+	maxImportLineSize         = 1 << 20
+	importListSeparator       = ";"
+
+	jobImportErrorMsg     = "error when importing jobs"
+	importMalformedRowMsg = "Malformed Row"
+	importMissingTitleMsg = "Missing Title"
+	importInsertFailedMsg = "Unable to Insert Job"
+
+	defaultDuplicateThreshold  = 0.8
+	defaultDuplicateWindowDays = 30
+	duplicateShingleSize       = 3
+	duplicateJobWarningMsg     = "Possible duplicate of %s"
+
+	defaultRadiusKm = 25
+	maxRadiusKm     = 500
+
+	defaultJobLanguage    = "en"
+	maxTranslationsPerJob = 10
+
+	maxDescriptionLength = 20000
+	maxRequirementLength = 20000
+
+	maxJobLinks         = 5
+	minShoutingLetters  = 10
+	maxUppercaseRatio   = 0.7
+	bannedPhraseFlag    = "Banned phrase: %s"
+	tooManyLinksFlag    = "Too many links"
+	shoutingTitleFlag   = "All caps title"
+	repeatedSymbolsFlag = "Repeated symbols"
+
+	unverifiedEmployerFlag    = "Unverified employer"
+	maxModerationReasonLength = 1000
+	moderationNotifyErrorMsg  = "error when notifying moderation outcome"
+
+	defaultReportThreshold = 3
+	maxReportDetailsLength = 2000
+	reportedJobFlag        = "Reported by %d talents"
+
+	maxStatusNoteLength = 1000
+
+	maxCoverLetterLength = 10000
+	maxApplicationLinks  = 5
+	maxLinkLength        = 2048
+
+	maxApplicationAttachments = 5
+
+	maxApplicationNoteLength = 5000
+	maxApplicationTags       = 20
+	maxTagLength             = 50
+
+	maxScorecardCriteria   = 10
+	maxCriterionNameLength = 100
+	defaultCriterionWeight = 1
+	maxCriterionWeight     = 10
+	minCriterionScore      = 1
+	maxCriterionScore      = 5
+
+	maxInterviewSlots          = 20
+	minInterviewDuration       = 15 * time.Minute
+	maxInterviewDuration       = 8 * time.Hour
+	maxInterviewLocationLength = 255
+	interviewUIDFormat         = "interview-%d@job-portal"
+	interviewInviteFileName    = "interview.ics"
+	interviewDateFormat        = "Mon, 02 Jan 2006 15:04 MST"
+	interviewNotifyErrorMsg    = "error when notifying interview"
+)
+
+var (
+	ErrInvalidSort  = errors.New("Invalid Sort")
+	ErrInvalidSkill = errors.New("Invalid Skill")
+
+	ErrInvalidDescription = errors.New("Invalid Description")
+	ErrInvalidRequirement = errors.New("Invalid Requirement")
+
+	ErrInvalidExpireDate = errors.New("Invalid Expire Date")
+	ErrInvalidJobStatus  = errors.New("Invalid Job Status")
+	ErrJobClosed         = errors.New("Job Closed")
+
+	ErrInvalidStatus     = errors.New("Invalid Status")
+	ErrInvalidNote       = errors.New("Invalid Note")
+	ErrReapplyNotAllowed = errors.New("Reapply Not Allowed")
+	ErrInvalidAttachment = errors.New("Invalid Attachment")
+
+	ErrInvalidRequiredField  = errors.New("Invalid Required Field")
+	ErrInvalidCoverLetter    = errors.New("Invalid Cover Letter")
+	ErrInvalidExpectedSalary = errors.New("Invalid Expected Salary")
+	ErrInvalidAvailableFrom  = errors.New("Invalid Available From")
+	ErrInvalidLink           = errors.New("Invalid Link")
+
+	ErrInvalidTag       = errors.New("Invalid Tag")
+	ErrInvalidScorecard = errors.New("Invalid Scorecard")
+	ErrInvalidScore     = errors.New("Invalid Score")
+
+	ErrInvalidInterviewSlot     = errors.New("Invalid Interview Slot")
+	ErrInterviewSlotConflict    = errors.New("Interview Slot Conflict")
+	ErrInterviewSlotUnavailable = errors.New("Interview Slot Unavailable")
+	ErrNotInInterview           = errors.New("Application Not In Interview")
+
+	ErrInvalidOpenings        = errors.New("Invalid Openings")
+	ErrInvalidMaxApplications = errors.New("Invalid Max Applications")
+
+	ErrInvalidSavedSearch = errors.New("Invalid Saved Search")
+
+	ErrInvalidQuestion = errors.New("Invalid Question")
+	ErrInvalidAnswer   = errors.New("Invalid Answer")
+
+	ErrInvalidImport = errors.New("Invalid Import")
+
+	ErrInvalidRevision = errors.New("Invalid Revision")
+
+	ErrDuplicateJob = errors.New("Duplicate Job")
+
+	ErrInvalidCity     = errors.New("Invalid City")
+	ErrInvalidLocation = errors.New("Invalid Location")
+
+	ErrInvalidReason = errors.New("Invalid Reason")
+
+	ErrInvalidReport   = errors.New("Invalid Report")
+	ErrDuplicateReport = errors.New("Duplicate Report")
+
+	ErrInvalidLanguage    = errors.New("Invalid Language")
+	ErrInvalidTranslation = errors.New("Invalid Translation")
+)
+
+// InvalidTransitionError is returned when an application can't move from its
+// current status to the requested one.
+type InvalidTransitionError struct {
+	From int
+	To   int
+}
+
+func (e *InvalidTransitionError) Error() string {
+	return fmt.Sprintf("Invalid Status Transition from %d to %d", e.From, e.To)
+}
+
+// MissingFieldError is returned when an application leaves out a field the
+// job requires.
+type MissingFieldError struct {
+	Field string
+}
+
+func (e *MissingFieldError) Error() string {
+	return fmt.Sprintf("Missing Required Field %s", e.Field)
+}
+
+// applicationFields are the optional application fields an employer can
+// require, in the order they are listed on a job.
+var applicationFields = []string{enum.CoverLetterField, enum.ExpectedSalaryField, enum.AvailableFromField, enum.LinksField}
+
+// applicationTransitions lists the statuses an employer can move an application
+// to from each status. Accepted, declined and withdrawn applications are
+// final.
+var applicationTransitions = map[int][]int{
+	enum.CreatedStatus:   {enum.InterviewStatus, enum.AcceptedStatus, enum.DeclinedStatus},
+	enum.InterviewStatus: {enum.AcceptedStatus, enum.DeclinedStatus},
+}
+
+var utf8BOM = []byte("\xef\xbb\xbf")
+
+var (
+	linkPattern            = regexp.MustCompile(`(?i)https?://|www\.`)
+	repeatedSymbolsPattern = regexp.MustCompile(`[!?$*]{3,}`)
+)
+
 type jobImpl struct {
 	appDB                appDB.AppDBInterface
 	refreshTokenDuration time.Duration
+	notifier             notifier.Notifier
+	moderation           config.ModerationConfig
+	applications         config.ApplicationsConfig
 }
 
-func NewJobImpl(appDB appDB.AppDBInterface, refreshTokenDuration time.Duration) JobUsecase {
+func NewJobImpl(appDB appDB.AppDBInterface, refreshTokenDuration time.Duration, notifier notifier.Notifier, moderation config.ModerationConfig, applications config.ApplicationsConfig) JobUsecase {
+	if moderation.DuplicatePolicy == "" {
+		moderation.DuplicatePolicy = enum.DuplicatePolicyWarn
+	}
+	if moderation.DuplicateThreshold <= 0 {
+		moderation.DuplicateThreshold = defaultDuplicateThreshold
+	}
+	if moderation.DuplicateWindowDays <= 0 {
+		moderation.DuplicateWindowDays = defaultDuplicateWindowDays
+	}
+	if moderation.ReportThreshold <= 0 {
+		moderation.ReportThreshold = defaultReportThreshold
+	}
+	if applications.ReapplyPolicy == "" {
+		applications.ReapplyPolicy = enum.ReapplyPolicyNever
+	}
+
 	return &jobImpl{
 		appDB:                appDB,
 		refreshTokenDuration: refreshTokenDuration,
+		notifier:             notifier,
+		moderation:           moderation,
+		applications:         applications,
+	}
+}
+
+func (u *jobImpl) GetAllJob(ctx context.Context, req request.SearchJobRequest) (*[]model.Job, error) {
+	skills, err := normalizeSkills(req.Skills)
+	if err != nil {
+		return nil, err
+	}
+
+	origin, radiusKm, err := searchOrigin(req)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := model.JobFilter{Skills: skills, OpenOnly: true}
+	if origin != nil {
+		box := geo.Box(*origin, radiusKm)
+		filter.Bounds = &model.GeoBounds{
+			MinLatitude:  box.MinLatitude,
+			MaxLatitude:  box.MaxLatitude,
+			MinLongitude: box.MinLongitude,
+			MaxLongitude: box.MaxLongitude,
+		}
+	}
+
+	jobs, err := u.appDB.GetAllJob(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if origin != nil {
+		// The bounding box is only a prefilter, its corners lie further away
+		// than the radius.
+		nearby := []model.Job{}
+		for _, job := range *jobs {
+			distance := geo.Distance(*origin, geo.Point{Latitude: *job.Latitude, Longitude: *job.Longitude})
+			if distance <= radiusKm {
+				distance = math.Round(distance*10) / 10
+				job.DistanceKm = &distance
+				nearby = append(nearby, job)
+			}
+		}
+
+		if req.Sort == enum.SortByDistance {
+			sort.SliceStable(nearby, func(i, j int) bool {
+				return *nearby[i].DistanceKm < *nearby[j].DistanceKm
+			})
+		}
+		jobs = &nearby
+	}
+
+	err = u.localizeJobs(ctx, *jobs, req.Languages)
+	if err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// localizeJobs swaps the title, description and requirement of every job for
+// the translation best matching the preferred languages, keeping the primary
+// language when none of them is available.
+func (u *jobImpl) localizeJobs(ctx context.Context, jobs []model.Job, preferences []string) error {
+	if len(jobs) == 0 {
+		return nil
 	}
+
+	jobIds := []int{}
+	for _, job := range jobs {
+		jobIds = append(jobIds, job.ID)
+	}
+	translations, err := u.appDB.GetJobTranslations(ctx, jobIds)
+	if err != nil {
+		return err
+	}
+
+	translationsByJob := make(map[int][]model.JobTranslation)
+	for _, translation := range *translations {
+		translationsByJob[translation.JobId] = append(translationsByJob[translation.JobId], translation)
+	}
+
+	for i := range jobs {
+		job := &jobs[i]
+		job.Languages = []string{job.Language}
+		for _, translation := range translationsByJob[job.ID] {
+			job.Languages = append(job.Languages, translation.Language)
+		}
+
+		language := locale.Match(preferences, job.Languages, job.Language)
+		for _, translation := range translationsByJob[job.ID] {
+			if translation.Language != language {
+				continue
+			}
+			job.Language = translation.Language
+			job.Title = translation.Title
+			if translation.Description != "" {
+				job.Description = translation.Description
+			}
+			if translation.Requirement != "" {
+				job.Requirement = translation.Requirement
+			}
+		}
+	}
+	return nil
 }
 
-func (u *jobImpl) GetAllJob(ctx context.Context) (*[]model.Job, error) {
-	return u.appDB.GetAllJob(ctx)
+// searchOrigin validates the location part of a job search and returns the
+// point to search around, or nil when the search is not limited by distance.
+func searchOrigin(req request.SearchJobRequest) (*geo.Point, float64, error) {
+	if req.Sort != "" && req.Sort != enum.SortByDistance {
+		return nil, 0, ErrInvalidSort
+	}
+
+	if req.Latitude == nil && req.Longitude == nil {
+		if req.RadiusKm != nil || req.Sort == enum.SortByDistance {
+			return nil, 0, ErrInvalidLocation
+		}
+		return nil, 0, nil
+	}
+	if req.Latitude == nil || req.Longitude == nil {
+		return nil, 0, ErrInvalidLocation
+	}
+
+	origin := geo.Point{Latitude: *req.Latitude, Longitude: *req.Longitude}
+	if !geo.Valid(origin) {
+		return nil, 0, ErrInvalidLocation
+	}
+
+	radiusKm := float64(defaultRadiusKm)
+	if req.RadiusKm != nil {
+		radiusKm = *req.RadiusKm
+	}
+	if !(radiusKm > 0 && radiusKm <= maxRadiusKm) {
+		return nil, 0, ErrInvalidLocation
+	}
+	return &origin, radiusKm, nil
 }
 
 func (u *jobImpl) GetJobById(ctx context.Context, req request.SearchJobByIdRequest) (*model.Job, error) {
-	return u.appDB.GetJobById(ctx, req.JobId)
+	job, err := u.appDB.GetJobById(ctx, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+	if job.ModerationStatus != enum.ApprovedModerationStatus {
+		return nil, sql.ErrNoRows
+	}
+
+	questions, err := u.appDB.GetJobQuestions(ctx, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Knockout rules stay hidden from talents so they can't tailor their answers.
+	for i := range *questions {
+		question := &(*questions)[i]
+		question.KnockoutAnswers = nil
+		question.KnockoutMin = nil
+		question.KnockoutMax = nil
+	}
+	job.Questions = *questions
+
+	jobs := []model.Job{*job}
+	err = u.localizeJobs(ctx, jobs, req.Languages)
+	if err != nil {
+		return nil, err
+	}
+	job = &jobs[0]
+
+	// Descriptions are stored as Markdown and rendered to sanitised HTML on
+	// the way out, so clients never have to trust the raw source.
+	job.DescriptionHTML = markdown.ToHTML(job.Description)
+	job.RequirementHTML = markdown.ToHTML(job.Requirement)
+	return job, nil
 }
 
-func (u *jobImpl) InsertJob(ctx context.Context, req request.InsertJobRequest) error {
-	return u.appDB.InsertJob(ctx, req.EmployerId, req.Title, req.Description, req.Requirement)
+func (u *jobImpl) InsertJob(ctx context.Context, req request.InsertJobRequest) (*model.InsertJobResult, error) {
+	job, err := newJob(req)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates, err := u.getDuplicateCandidates(ctx, job.EmployerId)
+	if err != nil {
+		return nil, err
+	}
+
+	result := model.InsertJobResult{Warnings: []string{}}
+	if i := findDuplicateJob(job, candidates, u.moderation.DuplicateThreshold); i >= 0 {
+		if u.moderation.DuplicatePolicy == enum.DuplicatePolicyReject {
+			return nil, ErrDuplicateJob
+		}
+		result.Warnings = append(result.Warnings, fmt.Sprintf(duplicateJobWarningMsg, "job "+strconv.Itoa(candidates[i].ID)))
+	}
+
+	verified, err := u.isEmployerVerified(ctx, job.EmployerId)
+	if err != nil {
+		return nil, err
+	}
+
+	screenJob(&job, verified, u.moderation.BannedPhrases)
+	result.JobId, err = u.appDB.InsertJob(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+	result.ModerationStatus = job.ModerationStatus
+
+	go u.notifySavedSearches(context.Background(), result.JobId)
+	return &result, nil
 }
 
-func (u *jobImpl) GetApplicationsByJobId(ctx context.Context, req request.SearchApplicationByJobRequest) (*[]model.Application, error) {
-	return u.appDB.GetApplicationsByJobId(ctx, req.JobId, req.EmployerId)
+func (u *jobImpl) UpdateJob(ctx context.Context, req request.UpdateJobRequest) error {
+	job, err := newJob(request.InsertJobRequest{
+		EmployerId:      req.EmployerId,
+		Title:           req.Title,
+		Description:     req.Description,
+		Requirement:     req.Requirement,
+		Skills:          req.Skills,
+		ExpireDate:      req.ExpireDate,
+		Openings:        req.Openings,
+		MaxApplications: req.MaxApplications,
+		City:            req.City,
+		Language:        req.Language,
+		Translations:    req.Translations,
+		RequiredFields:  req.RequiredFields,
+	})
+	if err != nil {
+		return err
+	}
+	job.ID = req.JobId
+
+	verified, err := u.isEmployerVerified(ctx, job.EmployerId)
+	if err != nil {
+		return err
+	}
+
+	// Edits only ever move a job back to moderation. A clean edit keeps the
+	// current status, so a rejected job is not approved by editing it.
+	screenJob(&job, verified, u.moderation.BannedPhrases)
+	if job.ModerationStatus == enum.ApprovedModerationStatus {
+		job.ModerationStatus = 0
+	}
+	return u.appDB.UpdateJob(ctx, job)
 }
 
-func (u *jobImpl) GetApplicationById(ctx context.Context, req request.SearchApplicationByIdRequest) (*model.Application, error) {
-	if req.Role == 1 {
-		return u.appDB.GetApplicationByIdAndTalentId(ctx, req.ApplicationId, req.UserId)
+func (u *jobImpl) GetModerationJobs(ctx context.Context, req request.SearchModerationJobRequest) (*[]model.ModerationJob, int, error) {
+	moderationJobs, err := u.appDB.GetModerationJobs(ctx, req.Limit, (req.Page-1)*req.Limit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := u.appDB.CountModerationJobs(ctx)
+	if err != nil {
+		return nil, 0, err
 	}
-	return u.appDB.GetApplicationByIdAndEmployeerId(ctx, req.ApplicationId, req.UserId)
+	return moderationJobs, total, nil
 }
 
-func (u *jobImpl) InsertApplication(ctx context.Context, req request.InsertApplicationRequest) error {
-	return u.appDB.InsertApplication(ctx, req.JobId, req.TalentId)
+// ModerateJob approves or rejects a job waiting in the moderation queue and
+// lets the employer know. Rejections need a reason the employer can act on.
+func (u *jobImpl) ModerateJob(ctx context.Context, req request.ModerateJobRequest) error {
+	req.Reason = strings.TrimSpace(req.Reason)
+	if len(req.Reason) > maxModerationReasonLength {
+		return ErrInvalidReason
+	}
+	if req.Decision == enum.RejectedModerationStatus && req.Reason == "" {
+		return ErrInvalidReason
+	}
+
+	job, err := u.appDB.GetJobById(ctx, req.JobId)
+	if err != nil {
+		return err
+	}
+
+	err = u.appDB.ReviewJob(ctx, req.JobId, req.AdminId, req.Decision, req.Reason)
+	if err != nil {
+		return err
+	}
+
+	employer, err := u.appDB.GetUserById(ctx, job.EmployerId)
+	if err != nil {
+		log.PrintLogErr(ctx, moderationNotifyErrorMsg, err)
+	} else {
+		err = u.notifier.Notify(ctx, moderationNotification(employer.Email, *job, req.Decision, req.Reason))
+		if err != nil {
+			log.PrintLogErr(ctx, moderationNotifyErrorMsg, err)
+		}
+	}
+
+	if req.Decision == enum.ApprovedModerationStatus {
+		go u.notifySavedSearches(context.Background(), req.JobId)
+	}
+	return nil
 }
 
-func (u *jobImpl) UpdateApplicationStatus(ctx context.Context, req request.UpdateApplicationStatusRequest) error {
-	application, err := u.appDB.GetApplicationByIdAndEmployeerId(ctx, req.ApplicationId, req.EmployerId)
+// ReportJob records a talent's report against a published job. Once enough
+// talents reported the same job it is hidden until an admin reviews it.
+func (u *jobImpl) ReportJob(ctx context.Context, req request.InsertJobReportRequest) error {
+	req.Details = strings.TrimSpace(req.Details)
+	if req.Category < enum.ScamReportCategory || req.Category > enum.OtherReportCategory {
+		return ErrInvalidReport
+	}
+	if len(req.Details) > maxReportDetailsLength {
+		return ErrInvalidReport
+	}
+	if req.Category == enum.OtherReportCategory && req.Details == "" {
+		return ErrInvalidReport
+	}
+
+	job, err := u.appDB.GetJobById(ctx, req.JobId)
 	if err != nil {
 		return err
 	}
+	if job.ModerationStatus != enum.ApprovedModerationStatus {
+		return sql.ErrNoRows
+	}
 
-	job, err := u.appDB.GetJobById(ctx, application.JobId)
+	inserted, openReports, err := u.appDB.InsertJobReport(ctx, model.JobReport{
+		JobId:    req.JobId,
+		TalentId: req.TalentId,
+		Category: req.Category,
+		Details:  req.Details,
+	})
 	if err != nil {
 		return err
 	}
+	if !inserted {
+		return ErrDuplicateReport
+	}
 
-	if job.EmployerId != req.EmployerId {
-		return errors.New("Unauthorized")
+	if openReports >= u.moderation.ReportThreshold {
+		return u.appDB.HideJob(ctx, req.JobId, []string{fmt.Sprintf(reportedJobFlag, openReports)})
+	}
+	return nil
+}
+
+func (u *jobImpl) GetJobReports(ctx context.Context, req request.SearchJobReportRequest) (*[]model.JobReport, int, error) {
+	if req.ReportStatus < 0 || req.ReportStatus > enum.UpheldReportStatus {
+		return nil, 0, ErrInvalidReport
+	}
+
+	reports, err := u.appDB.GetJobReports(ctx, req.ReportStatus, req.Limit, (req.Page-1)*req.Limit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := u.appDB.CountJobReports(ctx, req.ReportStatus)
+	if err != nil {
+		return nil, 0, err
+	}
+	return reports, total, nil
+}
+
+// ResolveJobReport dismisses or upholds an open report. Upholding a report
+// rejects the job, so the resolution is sent to the employer as the reason.
+func (u *jobImpl) ResolveJobReport(ctx context.Context, req request.ResolveJobReportRequest) error {
+	req.Resolution = strings.TrimSpace(req.Resolution)
+	if req.ReportStatus != enum.DismissedReportStatus && req.ReportStatus != enum.UpheldReportStatus {
+		return ErrInvalidReport
+	}
+	if len(req.Resolution) > maxModerationReasonLength {
+		return ErrInvalidReason
+	}
+	if req.ReportStatus == enum.UpheldReportStatus && req.Resolution == "" {
+		return ErrInvalidReason
+	}
+
+	jobId, err := u.appDB.ResolveJobReport(ctx, req.ReportId, req.AdminId, req.ReportStatus, req.Resolution)
+	if err != nil {
+		return err
+	}
+	if req.ReportStatus != enum.UpheldReportStatus {
+		return nil
+	}
+
+	job, err := u.appDB.GetJobById(ctx, jobId)
+	if err != nil {
+		log.PrintLogErr(ctx, moderationNotifyErrorMsg, err)
+		return nil
+	}
+	employer, err := u.appDB.GetUserById(ctx, job.EmployerId)
+	if err != nil {
+		log.PrintLogErr(ctx, moderationNotifyErrorMsg, err)
+		return nil
 	}
+	err = u.notifier.Notify(ctx, moderationNotification(employer.Email, *job, enum.RejectedModerationStatus, req.Resolution))
+	if err != nil {
+		log.PrintLogErr(ctx, moderationNotifyErrorMsg, err)
+	}
+	return nil
+}
+
+func moderationNotification(recipient string, job model.Job, decision int, reason string) notifier.Notification {
+	outcome := "approved"
+	if decision == enum.RejectedModerationStatus {
+		outcome = "rejected"
+	}
+
+	body := fmt.Sprintf("Your job posting \"%s\" (job #%d) was %s by our moderators.", job.Title, job.ID, outcome)
+	if reason != "" {
+		body += "\n\nReason: " + reason
+	}
+
+	return notifier.Notification{
+		Recipient: recipient,
+		Subject:   fmt.Sprintf("Your job posting \"%s\" was %s", job.Title, outcome),
+		Body:      body,
+	}
+}
+
+func (u *jobImpl) GetJobRevisions(ctx context.Context, req request.SearchJobRevisionRequest) (*[]model.JobRevision, error) {
+	err := u.authorizeJobRevisions(ctx, req.JobId, req.UserId, req.Role)
+	if err != nil {
+		return nil, err
+	}
+	return u.appDB.GetJobRevisions(ctx, req.JobId)
+}
+
+func (u *jobImpl) GetJobRevisionDiff(ctx context.Context, req request.SearchJobRevisionDiffRequest) (*model.JobRevisionDiff, error) {
+	if req.FromRevision < 1 || req.ToRevision < 1 {
+		return nil, ErrInvalidRevision
+	}
+
+	err := u.authorizeJobRevisions(ctx, req.JobId, req.UserId, req.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := u.appDB.GetJobRevision(ctx, req.JobId, req.FromRevision)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := u.appDB.GetJobRevision(ctx, req.JobId, req.ToRevision)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.JobRevisionDiff{
+		JobId:        req.JobId,
+		FromRevision: from.Revision,
+		ToRevision:   to.Revision,
+		Changes:      diffJobRevisions(*from, *to),
+	}, nil
+}
+
+// authorizeJobRevisions lets the employer owning the job and the talents who
+// applied to it see its revisions. Everyone else gets sql.ErrNoRows so the
+// job's existence is not leaked.
+func (u *jobImpl) authorizeJobRevisions(ctx context.Context, jobId, userId, role int) error {
+	if role == enum.TalentRole {
+		applied, err := u.appDB.HasAppliedToJob(ctx, jobId, userId)
+		if err != nil {
+			return err
+		}
+		if !applied {
+			return sql.ErrNoRows
+		}
+		return nil
+	}
+
+	job, err := u.appDB.GetJobById(ctx, jobId)
+	if err != nil {
+		return err
+	}
+	if job.EmployerId != userId {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (u *jobImpl) ImportJobs(ctx context.Context, req request.ImportJobRequest) (*model.JobImport, error) {
+	if req.Mode == "" {
+		req.Mode = enum.AtomicImportMode
+	}
+	if req.Mode != enum.AtomicImportMode && req.Mode != enum.PartialImportMode {
+		return nil, ErrInvalidImport
+	}
+
+	var rows []importRow
+	var err error
+	switch req.Format {
+	case enum.ImportFormatCSV:
+		rows, err = parseCSVImport(req.Data)
+	case enum.ImportFormatJSONL:
+		rows, err = parseJSONLImport(req.Data)
+	default:
+		err = ErrInvalidImport
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 || len(rows) > maxImportRows {
+		return nil, ErrInvalidImport
+	}
+
+	jobImport := model.JobImport{
+		EmployerId:   req.EmployerId,
+		ImportStatus: enum.PendingImportStatus,
+		Mode:         req.Mode,
+		TotalRows:    len(rows),
+		Report:       []model.JobImportRow{},
+	}
+	err = u.appDB.InsertJobImport(ctx, &jobImport)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) > backgroundImportThreshold {
+		go u.runJobImport(context.Background(), jobImport, rows)
+		return &jobImport, nil
+	}
+
+	jobImport = u.runJobImport(ctx, jobImport, rows)
+	return &jobImport, nil
+}
+
+func (u *jobImpl) GetJobImport(ctx context.Context, req request.SearchJobImportRequest) (*model.JobImport, error) {
+	return u.appDB.GetJobImportByIdAndEmployerId(ctx, req.ImportId, req.EmployerId)
+}
+
+// runJobImport validates and inserts the rows, recording the outcome of every
+// row on the import. In atomic mode a single bad row rejects the whole file.
+func (u *jobImpl) runJobImport(ctx context.Context, jobImport model.JobImport, rows []importRow) model.JobImport {
+	jobImport.ImportStatus = enum.RunningImportStatus
+	err := u.appDB.UpdateJobImport(ctx, jobImport)
+	if err != nil {
+		log.PrintLogErr(ctx, jobImportErrorMsg, err)
+	}
+
+	candidates, err := u.getDuplicateCandidates(ctx, jobImport.EmployerId)
+	if err != nil {
+		log.PrintLogErr(ctx, jobImportErrorMsg, err)
+	}
+	verified, err := u.isEmployerVerified(ctx, jobImport.EmployerId)
+	if err != nil {
+		log.PrintLogErr(ctx, jobImportErrorMsg, err)
+	}
+	candidateLabels := []string{}
+	for _, candidate := range candidates {
+		candidateLabels = append(candidateLabels, "job "+strconv.Itoa(candidate.ID))
+	}
+
+	report := make([]model.JobImportRow, len(rows))
+	jobs := []model.Job{}
+	jobRows := []int{}
+	for i, row := range rows {
+		report[i].Row = i + 1
+		report[i].Errors = row.errors
+		if len(row.errors) > 0 {
+			continue
+		}
+
+		row.req.EmployerId = jobImport.EmployerId
+		job, err := newJob(row.req)
+		if err != nil {
+			report[i].Errors = []string{err.Error()}
+			continue
+		}
+		if strings.TrimSpace(job.Title) == "" {
+			report[i].Errors = []string{importMissingTitleMsg}
+			continue
+		}
 
-	if req.Status != enum.InterviewStatus && req.Status != enum.AcceptedStatus && req.Status != enum.DeclinedStatus {
-		return errors.New("Invalid Status")
+		// Rows are also compared with the earlier rows of the same file.
+		if j := findDuplicateJob(job, candidates, u.moderation.DuplicateThreshold); j >= 0 {
+			if u.moderation.DuplicatePolicy == enum.DuplicatePolicyReject {
+				report[i].Errors = []string{ErrDuplicateJob.Error()}
+				continue
+			}
+			report[i].Warnings = []string{fmt.Sprintf(duplicateJobWarningMsg, candidateLabels[j])}
+		}
+		candidates = append(candidates, job)
+		candidateLabels = append(candidateLabels, "row "+strconv.Itoa(i+1))
+
+		screenJob(&job, verified, u.moderation.BannedPhrases)
+		jobs = append(jobs, job)
+		jobRows = append(jobRows, i)
+	}
+
+	atomic := jobImport.Mode == enum.AtomicImportMode
+	if len(jobs) > 0 && (!atomic || len(jobs) == len(rows)) {
+		ids, err := u.appDB.InsertJobs(ctx, jobs, atomic)
+		if err != nil {
+			log.PrintLogErr(ctx, jobImportErrorMsg, err)
+			ids = make([]int, len(jobs))
+		}
+		for i, id := range ids {
+			if id == 0 {
+				report[jobRows[i]].Errors = []string{importInsertFailedMsg}
+				continue
+			}
+			report[jobRows[i]].JobId = id
+			go u.notifySavedSearches(context.Background(), id)
+		}
+	}
+
+	jobImport.InsertedRows, jobImport.FailedRows = 0, 0
+	for _, row := range report {
+		if row.JobId != 0 {
+			jobImport.InsertedRows++
+		}
+		if len(row.Errors) > 0 {
+			jobImport.FailedRows++
+		}
+	}
+
+	jobImport.ImportStatus = enum.CompletedImportStatus
+	if jobImport.InsertedRows == 0 {
+		jobImport.ImportStatus = enum.FailedImportStatus
+	}
+
+	finishDate := time.Now().UTC()
+	jobImport.Report = report
+	jobImport.FinishDate = &finishDate
+	err = u.appDB.UpdateJobImport(ctx, jobImport)
+	if err != nil {
+		log.PrintLogErr(ctx, jobImportErrorMsg, err)
+	}
+	return jobImport
+}
+
+func (u *jobImpl) UpdateJobStatus(ctx context.Context, req request.UpdateJobStatusRequest) error {
+	if req.Status != enum.OpenJobStatus && req.Status != enum.ClosedJobStatus {
+		return ErrInvalidJobStatus
+	}
+	return u.appDB.UpdateJobStatus(ctx, req.JobId, req.EmployerId, req.Status)
+}
+
+func (u *jobImpl) GetJobsByEmployerId(ctx context.Context, req request.SearchJobByEmployerRequest) (*[]model.EmployerJob, int, error) {
+	if req.SortBy == "" {
+		req.SortBy = enum.SortByCreateDate
+	}
+	if req.SortBy != enum.SortByCreateDate && req.SortBy != enum.SortByTitle && req.SortBy != enum.SortByApplications {
+		return nil, 0, ErrInvalidSort
+	}
+
+	req.SortOrder = strings.ToLower(req.SortOrder)
+	if req.SortOrder == "" {
+		req.SortOrder = enum.SortOrderDesc
+	}
+	if req.SortOrder != enum.SortOrderAsc && req.SortOrder != enum.SortOrderDesc {
+		return nil, 0, ErrInvalidSort
+	}
+
+	jobs, err := u.appDB.GetJobsByEmployerId(ctx, req.EmployerId, req.SortBy, req.SortOrder, req.Limit, (req.Page-1)*req.Limit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := u.appDB.CountJobsByEmployerId(ctx, req.EmployerId)
+	if err != nil {
+		return nil, 0, err
+	}
+	return jobs, total, nil
+}
+
+func (u *jobImpl) SearchSkills(ctx context.Context, req request.SearchSkillRequest) (*[]model.Skill, error) {
+	query := normalizeSkill(req.Query)
+	if len(query) > maxSkillLength {
+		return nil, ErrInvalidSkill
+	}
+	if req.Limit < 1 || req.Limit > maxSkillsResults {
+		req.Limit = maxSkillsResults
+	}
+	return u.appDB.SearchSkills(ctx, query, req.Limit)
+}
+
+func (u *jobImpl) GetApplicationsByJobId(ctx context.Context, req request.SearchApplicationByJobRequest) (*[]model.Application, error) {
+	applications, err := u.appDB.GetApplicationsByJobId(ctx, req.JobId, req.EmployerId, req.IncludeWithdrawn)
+	if err != nil {
+		return nil, err
+	}
+
+	answers, err := u.appDB.GetApplicationAnswersByJobId(ctx, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	attachments, err := u.appDB.GetAttachmentsByJobId(ctx, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := u.appDB.GetApplicationTagsByJobId(ctx, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	scores, err := u.appDB.GetApplicationScoresByJobId(ctx, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	answersByApplication := map[int][]model.ApplicationAnswer{}
+	for _, answer := range *answers {
+		answersByApplication[answer.ApplicationId] = append(answersByApplication[answer.ApplicationId], answer)
+	}
+	attachmentsByApplication := map[int][]model.Attachment{}
+	for _, attachment := range *attachments {
+		attachmentsByApplication[attachment.ApplicationId] = append(attachmentsByApplication[attachment.ApplicationId], attachment)
+	}
+	tagsByApplication := map[int][]string{}
+	for _, tag := range *tags {
+		tagsByApplication[tag.ApplicationId] = append(tagsByApplication[tag.ApplicationId], tag.Tag)
+	}
+	scoresByApplication := map[int][]model.CriterionScore{}
+	for _, score := range *scores {
+		scoresByApplication[score.ApplicationId] = append(scoresByApplication[score.ApplicationId], score)
+	}
+	for i := range *applications {
+		application := &(*applications)[i]
+		application.Answers = answersByApplication[application.ID]
+		application.Attachments = attachmentsByApplication[application.ID]
+		application.Tags = tagsByApplication[application.ID]
+		_, application.Score = buildScorecards(scoresByApplication[application.ID])
+	}
+	return applications, nil
+}
+
+func (u *jobImpl) GetApplicationById(ctx context.Context, req request.SearchApplicationByIdRequest) (*model.Application, error) {
+	application, err := u.getApplicationForUser(ctx, req.ApplicationId, req.UserId, req.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	answers, err := u.appDB.GetApplicationAnswersByApplicationId(ctx, application.ID)
+	if err != nil {
+		return nil, err
+	}
+	application.Answers = *answers
+
+	history, err := u.appDB.GetApplicationStatusHistory(ctx, application.ID)
+	if err != nil {
+		return nil, err
+	}
+	application.History = *history
+
+	attachments, err := u.appDB.GetAttachmentsByApplicationId(ctx, application.ID)
+	if err != nil {
+		return nil, err
+	}
+	application.Attachments = *attachments
+
+	interview, err := u.appDB.GetInterview(ctx, application.ID)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+	application.Interview = interview
+
+	// Notes, tags and scores are the employer's own and never shown to the
+	// talent.
+	if req.Role == 1 {
+		return application, nil
+	}
+
+	notes, err := u.appDB.GetApplicationNotes(ctx, application.ID)
+	if err != nil {
+		return nil, err
+	}
+	application.Notes = *notes
+
+	tags, err := u.appDB.GetApplicationTags(ctx, application.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, tag := range *tags {
+		application.Tags = append(application.Tags, tag.Tag)
+	}
+
+	scores, err := u.appDB.GetApplicationScores(ctx, application.ID)
+	if err != nil {
+		return nil, err
+	}
+	application.Scorecards, application.Score = buildScorecards(*scores)
+	return application, nil
+}
+
+func (u *jobImpl) GetApplicationsByTalentId(ctx context.Context, req request.SearchApplicationByTalentRequest) (*[]model.Application, int, error) {
+	for _, status := range req.Statuses {
+		if !isApplicationStatus(status) {
+			return nil, 0, ErrInvalidStatus
+		}
+	}
+
+	applications, err := u.appDB.GetApplicationsByTalentId(ctx, req.TalentId, req.Statuses, req.Limit, (req.Page-1)*req.Limit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := u.appDB.CountApplicationsByTalentId(ctx, req.TalentId, req.Statuses)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	now := time.Now()
+	for i := range *applications {
+		job := (*applications)[i].Job
+		job.IsClosed = job.JobStatus == enum.ClosedJobStatus
+		job.IsExpired = job.ExpireDate != nil && !job.ExpireDate.After(now)
+	}
+	return applications, total, nil
+}
+
+func isApplicationStatus(status int) bool {
+	switch status {
+	case enum.CreatedStatus, enum.InterviewStatus, enum.AcceptedStatus, enum.DeclinedStatus, enum.WithdrawnStatus:
+		return true
+	}
+	return false
+}
+
+func (u *jobImpl) InsertApplication(ctx context.Context, req request.InsertApplicationRequest) error {
+	job, err := u.appDB.GetJobById(ctx, req.JobId)
+	if err != nil {
+		return err
+	}
+
+	if job.JobStatus == enum.ClosedJobStatus || job.ModerationStatus != enum.ApprovedModerationStatus || isJobExpired(*job, time.Now()) {
+		return ErrJobClosed
+	}
+
+	application, err := newApplication(req, job.RequiredFields, time.Now())
+	if err != nil {
+		return err
+	}
+
+	questions, err := u.appDB.GetJobQuestions(ctx, req.JobId)
+	if err != nil {
+		return err
+	}
+
+	answers, err := evaluateAnswers(*questions, req.Answers)
+	if err != nil {
+		return err
+	}
+
+	attachments, err := u.getApplicationAttachments(ctx, req.AttachmentIds, req.TalentId)
+	if err != nil {
+		return err
+	}
+
+	err = u.checkReapply(ctx, req.JobId, req.TalentId)
+	if err != nil {
+		return err
+	}
+
+	application.Profile, err = u.getProfileSnapshot(ctx, req.TalentId)
+	if err != nil {
+		return err
+	}
+
+	application.ApplicationStatus = enum.CreatedStatus
+	for _, answer := range answers {
+		if answer.KnockedOut {
+			application.ApplicationStatus = enum.DeclinedStatus
+			break
+		}
+	}
+	application.Answers = answers
+	application.Attachments = attachments
+
+	applicationId, err := u.appDB.InsertApplication(ctx, application)
+	if err != nil {
+		return err
+	}
+	if applicationId == 0 {
+		return ErrJobClosed
+	}
+	return nil
+}
+
+func (u *jobImpl) UpdateApplicationStatus(ctx context.Context, req request.UpdateApplicationStatusRequest) error {
+	application, err := u.appDB.GetApplicationByIdAndEmployeerId(ctx, req.ApplicationId, req.EmployerId)
+	if err != nil {
+		return err
+	}
+
+	job, err := u.appDB.GetJobById(ctx, application.JobId)
+	if err != nil {
+		return err
+	}
+
+	if job.EmployerId != req.EmployerId {
+		return errors.New("Unauthorized")
+	}
+
+	if !isApplicationStatus(req.Status) {
+		return ErrInvalidStatus
+	}
+	if len(req.Note) > maxStatusNoteLength {
+		return ErrInvalidNote
+	}
+	if !canTransition(application.ApplicationStatus, req.Status) {
+		return &InvalidTransitionError{From: application.ApplicationStatus, To: req.Status}
+	}
+
+	from := application.ApplicationStatus
+	updated, err := u.appDB.UpdateApplicationStatus(ctx, model.ApplicationStatusChange{
+		ApplicationId: req.ApplicationId,
+		FromStatus:    &from,
+		ToStatus:      req.Status,
+		ActorId:       &req.EmployerId,
+		Note:          req.Note,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !updated {
+		// The status changed since it was read, report the transition from
+		// where the application is now.
+		application, err = u.appDB.GetApplicationByIdAndEmployeerId(ctx, req.ApplicationId, req.EmployerId)
+		if err != nil {
+			return err
+		}
+		return &InvalidTransitionError{From: application.ApplicationStatus, To: req.Status}
+	}
+
+	if req.Status != enum.InterviewStatus {
+		u.cancelUpcomingInterview(ctx, *application)
+	}
+	return nil
+}
+
+// getApplicationAttachments loads the attachments sent with an application,
+// all of which must belong to the talent.
+func (u *jobImpl) getApplicationAttachments(ctx context.Context, attachmentIds []int, talentId int) ([]model.Attachment, error) {
+	if len(attachmentIds) == 0 {
+		return nil, nil
+	}
+
+	seen := map[int]bool{}
+	uniqueIds := []int{}
+	for _, id := range attachmentIds {
+		if !seen[id] {
+			seen[id] = true
+			uniqueIds = append(uniqueIds, id)
+		}
+	}
+	if len(uniqueIds) > maxApplicationAttachments {
+		return nil, ErrInvalidAttachment
+	}
+
+	attachments, err := u.appDB.GetAttachmentsByIds(ctx, uniqueIds, talentId)
+	if err != nil {
+		return nil, err
+	}
+	if len(*attachments) != len(uniqueIds) {
+		return nil, ErrInvalidAttachment
+	}
+	return *attachments, nil
+}
+
+// getProfileSnapshot returns the talent's profile as it is when they apply, so
+// the employer reviews the application against what the talent showed them.
+// Talents without a profile or with a private one send none.
+func (u *jobImpl) getProfileSnapshot(ctx context.Context, talentId int) (*model.TalentProfile, error) {
+	profile, err := u.appDB.GetTalentProfile(ctx, talentId)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if profile.Visibility == enum.PrivateProfileVisibility {
+		return nil, nil
+	}
+	return profile, nil
+}
+
+// WithdrawApplication lets a talent take back an application the employer
+// hasn't decided on yet.
+func (u *jobImpl) WithdrawApplication(ctx context.Context, req request.WithdrawApplicationRequest) error {
+	application, err := u.appDB.GetApplicationByIdAndTalentId(ctx, req.ApplicationId, req.TalentId)
+	if err != nil {
+		return err
+	}
+
+	if len(req.Note) > maxStatusNoteLength {
+		return ErrInvalidNote
+	}
+	if !canWithdraw(application.ApplicationStatus) {
+		return &InvalidTransitionError{From: application.ApplicationStatus, To: enum.WithdrawnStatus}
+	}
+
+	from := application.ApplicationStatus
+	updated, err := u.appDB.UpdateApplicationStatus(ctx, model.ApplicationStatusChange{
+		ApplicationId: req.ApplicationId,
+		FromStatus:    &from,
+		ToStatus:      enum.WithdrawnStatus,
+		ActorId:       &req.TalentId,
+		Note:          req.Note,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !updated {
+		application, err = u.appDB.GetApplicationByIdAndTalentId(ctx, req.ApplicationId, req.TalentId)
+		if err != nil {
+			return err
+		}
+		return &InvalidTransitionError{From: application.ApplicationStatus, To: enum.WithdrawnStatus}
+	}
+
+	u.cancelUpcomingInterview(ctx, *application)
+	return nil
+}
+
+// checkReapply applies the reapply policy to a talent who withdrew from the
+// job before. Talents who never withdrew are left to the unique index, which
+// still allows a single active application per job.
+func (u *jobImpl) checkReapply(ctx context.Context, jobId, talentId int) error {
+	withdrawDate, err := u.appDB.GetLastWithdrawDate(ctx, jobId, talentId)
+	if err != nil {
+		return err
+	}
+	if withdrawDate == nil {
+		return nil
+	}
+
+	switch u.applications.ReapplyPolicy {
+	case enum.ReapplyPolicyAlways:
+		return nil
+	case enum.ReapplyPolicyCooldown:
+		cooldown := time.Duration(u.applications.ReapplyCooldownDays) * 24 * time.Hour
+		if time.Now().Before(withdrawDate.Add(cooldown)) {
+			return ErrReapplyNotAllowed
+		}
+		return nil
+	default:
+		return ErrReapplyNotAllowed
+	}
+}
+
+func canWithdraw(status int) bool {
+	return status == enum.CreatedStatus || status == enum.InterviewStatus
+}
+
+func canTransition(from, to int) bool {
+	for _, status := range applicationTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+// InsertApplicationNote adds a private note to an application to one of the
+// employer's jobs.
+func (u *jobImpl) InsertApplicationNote(ctx context.Context, req request.InsertApplicationNoteRequest) (*model.ApplicationNote, error) {
+	req.Body = strings.TrimSpace(req.Body)
+	if req.Body == "" || len(req.Body) > maxApplicationNoteLength {
+		return nil, ErrInvalidNote
+	}
+
+	_, err := u.appDB.GetApplicationByIdAndEmployeerId(ctx, req.ApplicationId, req.EmployerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.appDB.InsertApplicationNote(ctx, model.ApplicationNote{
+		ApplicationId: req.ApplicationId,
+		AuthorId:      &req.EmployerId,
+		Body:          req.Body,
+	})
+}
+
+func (u *jobImpl) DeleteApplicationNote(ctx context.Context, req request.DeleteApplicationNoteRequest) error {
+	_, err := u.appDB.GetApplicationByIdAndEmployeerId(ctx, req.ApplicationId, req.EmployerId)
+	if err != nil {
+		return err
+	}
+	return u.appDB.DeleteApplicationNote(ctx, req.NoteId, req.ApplicationId, req.EmployerId)
+}
+
+// UpdateApplicationTags replaces the tags of an application. Tags are lower
+// cased, so "Strong Hire" and "strong hire" are the same tag.
+func (u *jobImpl) UpdateApplicationTags(ctx context.Context, req request.UpdateApplicationTagsRequest) error {
+	seen := map[string]bool{}
+	tags := []string{}
+	for _, tag := range req.Tags {
+		tag = strings.Join(strings.Fields(strings.ToLower(tag)), " ")
+		if tag == "" || len(tag) > maxTagLength {
+			return ErrInvalidTag
+		}
+		if !seen[tag] {
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+	}
+	if len(tags) > maxApplicationTags {
+		return ErrInvalidTag
+	}
+
+	_, err := u.appDB.GetApplicationByIdAndEmployeerId(ctx, req.ApplicationId, req.EmployerId)
+	if err != nil {
+		return err
+	}
+	return u.appDB.UpdateApplicationTags(ctx, req.ApplicationId, tags)
+}
+
+func (u *jobImpl) GetScorecard(ctx context.Context, req request.SearchScorecardRequest) (*[]model.ScorecardCriterion, error) {
+	err := u.requireJobOwner(ctx, req.JobId, req.EmployerId)
+	if err != nil {
+		return nil, err
+	}
+	return u.appDB.GetScorecardCriteria(ctx, req.JobId)
+}
+
+// UpdateScorecard sets the criteria applications to a job are scored on. An
+// empty list removes the scorecard along with every score given on it.
+func (u *jobImpl) UpdateScorecard(ctx context.Context, req request.UpdateScorecardRequest) error {
+	if len(req.Criteria) > maxScorecardCriteria {
+		return ErrInvalidScorecard
+	}
+
+	seen := map[string]bool{}
+	criteria := []model.ScorecardCriterion{}
+	for i, criterion := range req.Criteria {
+		name := strings.TrimSpace(criterion.Name)
+		weight := criterion.Weight
+		if weight == 0 {
+			weight = defaultCriterionWeight
+		}
+		if name == "" || len(name) > maxCriterionNameLength || weight < 1 || weight > maxCriterionWeight || seen[strings.ToLower(name)] {
+			return ErrInvalidScorecard
+		}
+		seen[strings.ToLower(name)] = true
+		criteria = append(criteria, model.ScorecardCriterion{
+			JobId:    req.JobId,
+			Position: i + 1,
+			Name:     name,
+			Weight:   weight,
+		})
+	}
+
+	err := u.requireJobOwner(ctx, req.JobId, req.EmployerId)
+	if err != nil {
+		return err
+	}
+	return u.appDB.UpdateScorecardCriteria(ctx, req.JobId, criteria)
+}
+
+// UpdateApplicationScorecard replaces the reviewer's scores for an
+// application. Every score rates one of the job's criteria from 1 to 5, and
+// criteria left out are not counted.
+func (u *jobImpl) UpdateApplicationScorecard(ctx context.Context, req request.UpdateApplicationScorecardRequest) error {
+	application, err := u.appDB.GetApplicationByIdAndEmployeerId(ctx, req.ApplicationId, req.ReviewerId)
+	if err != nil {
+		return err
+	}
+
+	criteria, err := u.appDB.GetScorecardCriteria(ctx, application.JobId)
+	if err != nil {
+		return err
+	}
+
+	isCriterion := map[int]bool{}
+	for _, criterion := range *criteria {
+		isCriterion[criterion.ID] = true
+	}
+
+	if len(req.Scores) == 0 {
+		return ErrInvalidScore
+	}
+	seen := map[int]bool{}
+	scores := []model.CriterionScore{}
+	for _, score := range req.Scores {
+		if !isCriterion[score.CriterionId] || seen[score.CriterionId] || score.Score < minCriterionScore || score.Score > maxCriterionScore {
+			return ErrInvalidScore
+		}
+		seen[score.CriterionId] = true
+		scores = append(scores, model.CriterionScore{
+			CriterionId: score.CriterionId,
+			Score:       score.Score,
+		})
+	}
+	return u.appDB.UpdateApplicationScores(ctx, req.ApplicationId, req.ReviewerId, scores)
+}
+
+// InsertInterviewSlots publishes times the employer is available to interview
+// talents who applied to the job. A slot can't overlap any other slot of the
+// employer, whatever job it is for.
+func (u *jobImpl) InsertInterviewSlots(ctx context.Context, req request.InsertInterviewSlotsRequest) (*[]model.InterviewSlot, error) {
+	if len(req.Slots) == 0 || len(req.Slots) > maxInterviewSlots {
+		return nil, ErrInvalidInterviewSlot
+	}
+
+	now := time.Now()
+	slots := []model.InterviewSlot{}
+	for _, slot := range req.Slots {
+		location := strings.TrimSpace(slot.Location)
+		duration := slot.EndDate.Sub(slot.StartDate)
+		if !slot.StartDate.After(now) || duration < minInterviewDuration || duration > maxInterviewDuration || len(location) > maxInterviewLocationLength {
+			return nil, ErrInvalidInterviewSlot
+		}
+		// Slots are kept in UTC, as their columns don't store a time zone.
+		slots = append(slots, model.InterviewSlot{
+			JobId:     req.JobId,
+			StartDate: slot.StartDate.UTC(),
+			EndDate:   slot.EndDate.UTC(),
+			Location:  location,
+		})
+	}
+
+	err := u.requireJobOwner(ctx, req.JobId, req.EmployerId)
+	if err != nil {
+		return nil, err
+	}
+
+	inserted, data, err := u.appDB.InsertInterviewSlots(ctx, req.EmployerId, slots)
+	if err != nil {
+		return nil, err
+	}
+	if !inserted {
+		return nil, ErrInterviewSlotConflict
+	}
+	return data, nil
+}
+
+func (u *jobImpl) GetInterviewSlots(ctx context.Context, req request.SearchInterviewSlotsRequest) (*[]model.InterviewSlot, error) {
+	err := u.requireJobOwner(ctx, req.JobId, req.EmployerId)
+	if err != nil {
+		return nil, err
+	}
+	return u.appDB.GetInterviewSlotsByJobId(ctx, req.JobId)
+}
+
+// DeleteInterviewSlot removes one of the employer's slots. An interview
+// booked on it is cancelled and the talent is sent the cancellation.
+func (u *jobImpl) DeleteInterviewSlot(ctx context.Context, req request.DeleteInterviewSlotRequest) error {
+	interview, err := u.appDB.DeleteInterviewSlot(ctx, req.SlotId, req.EmployerId)
+	if err != nil {
+		return err
+	}
+	if interview == nil {
+		return nil
+	}
+
+	application, err := u.appDB.GetApplicationByIdAndEmployeerId(ctx, interview.ApplicationId, req.EmployerId)
+	if err != nil {
+		log.PrintLogErr(ctx, interviewNotifyErrorMsg, err)
+		return nil
+	}
+	u.notifyInterview(ctx, *application, *interview)
+	return nil
+}
+
+// GetAvailableInterviewSlots lists the slots the talent can book for an
+// application in the interview stage.
+func (u *jobImpl) GetAvailableInterviewSlots(ctx context.Context, req request.SearchAvailableInterviewSlotsRequest) (*[]model.InterviewSlot, error) {
+	application, err := u.appDB.GetApplicationByIdAndTalentId(ctx, req.ApplicationId, req.TalentId)
+	if err != nil {
+		return nil, err
+	}
+	if application.ApplicationStatus != enum.InterviewStatus {
+		return nil, ErrNotInInterview
+	}
+	return u.appDB.GetAvailableInterviewSlots(ctx, application.JobId, time.Now().UTC())
+}
+
+// BookInterview books a slot of the job for an application in the interview
+// stage. When an interview is already booked it is moved to the new slot,
+// and both sides are sent the updated invite.
+func (u *jobImpl) BookInterview(ctx context.Context, req request.BookInterviewRequest) (*model.Interview, error) {
+	application, err := u.appDB.GetApplicationByIdAndTalentId(ctx, req.ApplicationId, req.TalentId)
+	if err != nil {
+		return nil, err
+	}
+	if application.ApplicationStatus != enum.InterviewStatus {
+		return nil, ErrNotInInterview
+	}
+
+	slot, err := u.appDB.GetInterviewSlotById(ctx, req.SlotId)
+	if err != nil {
+		return nil, err
+	}
+	if slot.JobId != application.JobId {
+		return nil, sql.ErrNoRows
+	}
+	if slot.ApplicationId != nil && *slot.ApplicationId == application.ID {
+		// The interview is already in this slot, there is nothing to move.
+		return u.appDB.GetInterview(ctx, application.ID)
+	}
+	if !slot.StartDate.After(time.Now()) {
+		return nil, ErrInterviewSlotUnavailable
+	}
+
+	booked, interview, err := u.appDB.BookInterview(ctx, application.ID, req.TalentId, req.SlotId)
+	if err != nil {
+		return nil, err
+	}
+	if !booked {
+		return nil, ErrInterviewSlotUnavailable
+	}
+
+	u.notifyInterview(ctx, *application, *interview)
+	return interview, nil
+}
+
+// CancelInterview cancels the interview booked for an application, freeing
+// its slot. Either the talent or the employer can cancel.
+func (u *jobImpl) CancelInterview(ctx context.Context, req request.CancelInterviewRequest) error {
+	application, err := u.getApplicationForUser(ctx, req.ApplicationId, req.UserId, req.Role)
+	if err != nil {
+		return err
+	}
+
+	interview, err := u.appDB.CancelInterview(ctx, application.ID)
+	if err != nil {
+		return err
+	}
+
+	u.notifyInterview(ctx, *application, *interview)
+	return nil
+}
+
+// GetInterviewInvite returns the application's interview as an iCalendar
+// file, which is a cancellation once the interview is cancelled.
+func (u *jobImpl) GetInterviewInvite(ctx context.Context, req request.SearchInterviewRequest) ([]byte, error) {
+	application, err := u.getApplicationForUser(ctx, req.ApplicationId, req.UserId, req.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	interview, err := u.appDB.GetInterview(ctx, application.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := u.interviewEvent(ctx, *application, *interview)
+	if err != nil {
+		return nil, err
+	}
+	return ical.Encode(*event), nil
+}
+
+func (u *jobImpl) getApplicationForUser(ctx context.Context, applicationId, userId, role int) (*model.Application, error) {
+	if role == 1 {
+		return u.appDB.GetApplicationByIdAndTalentId(ctx, applicationId, userId)
+	}
+	return u.appDB.GetApplicationByIdAndEmployeerId(ctx, applicationId, userId)
+}
+
+// cancelUpcomingInterview cancels the interview of an application that left
+// the interview stage, unless it already took place.
+func (u *jobImpl) cancelUpcomingInterview(ctx context.Context, application model.Application) {
+	interview, err := u.appDB.GetInterview(ctx, application.ID)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			log.PrintLogErr(ctx, interviewNotifyErrorMsg, err)
+		}
+		return
+	}
+	if interview.InterviewStatus != enum.BookedInterviewStatus || !interview.StartDate.After(time.Now()) {
+		return
+	}
+
+	interview, err = u.appDB.CancelInterview(ctx, application.ID)
+	if err != nil {
+		log.PrintLogErr(ctx, interviewNotifyErrorMsg, err)
+		return
+	}
+	u.notifyInterview(ctx, application, *interview)
+}
+
+// interviewEvent builds the calendar event of an interview, organized by the
+// employer with the talent attending. The UID stays the same for the
+// application, so calendars update the event they already have.
+func (u *jobImpl) interviewEvent(ctx context.Context, application model.Application, interview model.Interview) (*ical.Event, error) {
+	job, err := u.appDB.GetJobById(ctx, application.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	talent, err := u.appDB.GetUserById(ctx, application.TalentId)
+	if err != nil {
+		return nil, err
+	}
+
+	employer, err := u.appDB.GetUserById(ctx, job.EmployerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ical.Event{
+		UID:         fmt.Sprintf(interviewUIDFormat, application.ID),
+		Sequence:    interview.Sequence,
+		Stamp:       time.Now(),
+		Start:       interview.StartDate,
+		End:         interview.EndDate,
+		Summary:     fmt.Sprintf("Interview for %s", job.Title),
+		Description: fmt.Sprintf("Interview for the job \"%s\" (job #%d), application #%d.", job.Title, job.ID, application.ID),
+		Location:    interview.Location,
+		Organizer:   employer.Email,
+		Attendees:   []string{talent.Email},
+		Cancelled:   interview.InterviewStatus == enum.CancelledInterviewStatus,
+	}, nil
+}
+
+// notifyInterview sends the employer and the talent the interview's invite,
+// or its cancellation. Failures are only logged, as the interview is already
+// saved.
+func (u *jobImpl) notifyInterview(ctx context.Context, application model.Application, interview model.Interview) {
+	event, err := u.interviewEvent(ctx, application, interview)
+	if err != nil {
+		log.PrintLogErr(ctx, interviewNotifyErrorMsg, err)
+		return
+	}
+
+	invite := ical.Encode(*event)
+	for _, recipient := range append([]string{event.Organizer}, event.Attendees...) {
+		err = u.notifier.Notify(ctx, interviewNotification(recipient, *event, invite))
+		if err != nil {
+			log.PrintLogErr(ctx, interviewNotifyErrorMsg, err)
+		}
+	}
+}
+
+func interviewNotification(recipient string, event ical.Event, invite []byte) notifier.Notification {
+	start := event.Start.UTC().Format(interviewDateFormat)
+	location := ""
+	if event.Location != "" {
+		location = "\n\nLocation: " + event.Location
+	}
+
+	var subject, body string
+	switch {
+	case event.Cancelled:
+		subject = "Interview cancelled: " + event.Summary
+		body = fmt.Sprintf("The interview on %s was cancelled.", start)
+	case event.Sequence > 0:
+		subject = "Interview rescheduled: " + event.Summary
+		body = fmt.Sprintf("The interview was moved to %s, for %d minutes.%s", start, int(event.End.Sub(event.Start).Minutes()), location)
+	default:
+		subject = "Interview scheduled: " + event.Summary
+		body = fmt.Sprintf("The interview is scheduled on %s, for %d minutes.%s", start, int(event.End.Sub(event.Start).Minutes()), location)
+	}
+
+	return notifier.Notification{
+		Recipient: recipient,
+		Subject:   subject,
+		Body:      body + "\n\n" + event.Description,
+		Attachments: []notifier.Attachment{{
+			FileName:    interviewInviteFileName,
+			ContentType: ical.ContentType + "; method=" + event.Method(),
+			Content:     invite,
+		}},
+	}
+}
+
+// requireJobOwner reports jobs the employer doesn't own as missing.
+func (u *jobImpl) requireJobOwner(ctx context.Context, jobId, employerId int) error {
+	job, err := u.appDB.GetJobById(ctx, jobId)
+	if err != nil {
+		return err
+	}
+	if job.EmployerId != employerId {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// buildScorecards groups the scores of one application by reviewer, in the
+// order they are given. Each reviewer's score is the weighted mean of their
+// scores, while the overall score is the weighted mean of every criterion's
+// average across reviewers, so a criterion counts the same however many
+// reviewers scored it.
+func buildScorecards(scores []model.CriterionScore) ([]model.Scorecard, *float64) {
+	if len(scores) == 0 {
+		return nil, nil
+	}
+
+	type criterionTotal struct {
+		weight, sum, count int
+	}
+	totals := map[int]*criterionTotal{}
+	criterionIds := []int{}
+
+	scorecards := []model.Scorecard{}
+	for _, score := range scores {
+		if n := len(scorecards); n == 0 || scorecards[n-1].ReviewerId != score.ReviewerId {
+			scorecards = append(scorecards, model.Scorecard{ReviewerId: score.ReviewerId})
+		}
+		scorecard := &scorecards[len(scorecards)-1]
+		scorecard.Scores = append(scorecard.Scores, score)
+
+		total, ok := totals[score.CriterionId]
+		if !ok {
+			total = &criterionTotal{weight: score.Weight}
+			totals[score.CriterionId] = total
+			criterionIds = append(criterionIds, score.CriterionId)
+		}
+		total.sum += score.Score
+		total.count++
+	}
+
+	for i := range scorecards {
+		var weighted, weights float64
+		for _, score := range scorecards[i].Scores {
+			weighted += float64(score.Weight * score.Score)
+			weights += float64(score.Weight)
+		}
+		scorecards[i].Score = roundScore(weighted / weights)
+	}
+
+	var weighted, weights float64
+	for _, id := range criterionIds {
+		total := totals[id]
+		weighted += float64(total.weight) * float64(total.sum) / float64(total.count)
+		weights += float64(total.weight)
+	}
+	overall := roundScore(weighted / weights)
+	return scorecards, &overall
+}
+
+func roundScore(score float64) float64 {
+	return math.Round(score*100) / 100
+}
+
+// SaveJob adds a job to the talent's saved jobs. Jobs that are pending,
+// rejected or hidden after reports are reported as missing, as in GET /jobs.
+func (u *jobImpl) SaveJob(ctx context.Context, req request.SaveJobRequest) error {
+	job, err := u.appDB.GetJobById(ctx, req.JobId)
+	if err != nil {
+		return err
+	}
+	if job.ModerationStatus != enum.ApprovedModerationStatus {
+		return sql.ErrNoRows
+	}
+	return u.appDB.InsertSavedJob(ctx, req.TalentId, req.JobId)
+}
+
+func (u *jobImpl) UnsaveJob(ctx context.Context, req request.SaveJobRequest) error {
+	return u.appDB.DeleteSavedJob(ctx, req.TalentId, req.JobId)
+}
+
+func (u *jobImpl) GetSavedJobs(ctx context.Context, req request.SearchSavedJobRequest) (*[]model.SavedJob, int, error) {
+	savedJobs, err := u.appDB.GetSavedJobs(ctx, req.TalentId, req.Limit, (req.Page-1)*req.Limit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := u.appDB.CountSavedJobs(ctx, req.TalentId)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	now := time.Now()
+	for i := range *savedJobs {
+		savedJob := &(*savedJobs)[i]
+		savedJob.IsClosed = savedJob.Job.JobStatus == enum.ClosedJobStatus
+		savedJob.IsExpired = isJobExpired(savedJob.Job, now)
+	}
+	return savedJobs, total, nil
+}
+
+func (u *jobImpl) InsertSavedSearch(ctx context.Context, req request.InsertSavedSearchRequest) error {
+	name := strings.TrimSpace(req.Name)
+	keywords := strings.TrimSpace(req.Keywords)
+	if name == "" || len(name) > maxSavedSearchNameLength || len(keywords) > maxSavedSearchKeywordsLength {
+		return ErrInvalidSavedSearch
+	}
+
+	if req.Frequency == 0 {
+		req.Frequency = enum.InstantAlertFrequency
+	}
+	if req.Frequency != enum.InstantAlertFrequency && req.Frequency != enum.DailyAlertFrequency {
+		return ErrInvalidSavedSearch
+	}
+
+	skills, err := normalizeSkills(req.Skills)
+	if err != nil {
+		return err
+	}
+	if len(skills) > 0 {
+		skills, err = u.appDB.ResolveSkillNames(ctx, skills)
+		if err != nil {
+			return err
+		}
+	}
+
+	if keywords == "" && len(skills) == 0 && req.CompanyId == nil {
+		return ErrInvalidSavedSearch
+	}
+
+	return u.appDB.InsertSavedSearch(ctx, model.SavedSearch{
+		TalentId:  req.TalentId,
+		Name:      name,
+		Keywords:  keywords,
+		Skills:    skills,
+		CompanyId: req.CompanyId,
+		Frequency: req.Frequency,
+	})
+}
+
+func (u *jobImpl) GetSavedSearches(ctx context.Context, req request.SearchSavedSearchRequest) (*[]model.SavedSearch, error) {
+	return u.appDB.GetSavedSearchesByTalentId(ctx, req.TalentId)
+}
+
+func (u *jobImpl) DeleteSavedSearch(ctx context.Context, req request.DeleteSavedSearchRequest) error {
+	return u.appDB.DeleteSavedSearch(ctx, req.SavedSearchId, req.TalentId)
+}
+
+// SendSavedSearchDigest delivers every match that has not been notified yet,
+// one notification per talent. Matches of instant searches end up here too
+// when their first delivery failed.
+func (u *jobImpl) SendSavedSearchDigest(ctx context.Context) error {
+	matches, err := u.appDB.GetPendingSavedSearchMatches(ctx)
+	if err != nil {
+		return err
+	}
+
+	for start := 0; start < len(*matches); {
+		end := start
+		for end < len(*matches) && (*matches)[end].SavedSearch.TalentId == (*matches)[start].SavedSearch.TalentId {
+			end++
+		}
+		talentMatches := (*matches)[start:end]
+		start = end
+
+		err = u.notifier.Notify(ctx, savedSearchDigestNotification(talentMatches))
+		if err != nil {
+			log.PrintLogErr(ctx, savedSearchNotifyErrorMsg, err)
+			continue
+		}
+
+		err = u.appDB.UpdateSavedSearchMatchesNotified(ctx, talentMatches)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// notifySavedSearches records the job as a match of every saved search it
+// satisfies and notifies the talents whose searches ask for instant alerts.
+// It runs after the job is committed, so failures are logged instead of
+// failing the posting.
+func (u *jobImpl) notifySavedSearches(ctx context.Context, jobId int) {
+	job, err := u.appDB.GetJobById(ctx, jobId)
+	if err != nil {
+		log.PrintLogErr(ctx, savedSearchNotifyErrorMsg, err)
+		return
+	}
+	if job.ModerationStatus != enum.ApprovedModerationStatus {
+		return
+	}
+
+	var companyId *int
+	if job.Company != nil {
+		companyId = &job.Company.ID
+	}
+
+	savedSearches, err := u.appDB.GetSavedSearchesBySkills(ctx, job.Skills, companyId)
+	if err != nil {
+		log.PrintLogErr(ctx, savedSearchNotifyErrorMsg, err)
+		return
+	}
+
+	document := strings.Join([]string{job.Title, job.Description, job.Requirement}, " ")
+	for _, savedSearch := range *savedSearches {
+		if !text.ContainsAll(document, savedSearch.Keywords) {
+			continue
+		}
+
+		inserted, err := u.appDB.InsertSavedSearchMatch(ctx, savedSearch.ID, job.ID)
+		if err != nil {
+			log.PrintLogErr(ctx, savedSearchNotifyErrorMsg, err)
+			continue
+		}
+		if !inserted || savedSearch.Frequency != enum.InstantAlertFrequency {
+			continue
+		}
+
+		match := model.SavedSearchMatch{SavedSearch: savedSearch, Job: *job}
+		err = u.notifier.Notify(ctx, savedSearchDigestNotification([]model.SavedSearchMatch{match}))
+		if err != nil {
+			log.PrintLogErr(ctx, savedSearchNotifyErrorMsg, err)
+			continue
+		}
+
+		err = u.appDB.UpdateSavedSearchMatchesNotified(ctx, []model.SavedSearchMatch{match})
+		if err != nil {
+			log.PrintLogErr(ctx, savedSearchNotifyErrorMsg, err)
+		}
+	}
+}
+
+func savedSearchDigestNotification(matches []model.SavedSearchMatch) notifier.Notification {
+	var subject string
+	if len(matches) == 1 {
+		subject = fmt.Sprintf("New job matching \"%s\": %s", matches[0].SavedSearch.Name, matches[0].Job.Title)
+	} else {
+		subject = fmt.Sprintf("%d new jobs matching your saved searches", len(matches))
+	}
+
+	var body strings.Builder
+	for _, match := range matches {
+		fmt.Fprintf(&body, "[%s] %s (job #%d)", match.SavedSearch.Name, match.Job.Title, match.Job.ID)
+		if match.Job.Company != nil {
+			fmt.Fprintf(&body, " at %s", match.Job.Company.Name)
+		}
+		body.WriteString("\n")
+	}
+
+	return notifier.Notification{
+		Recipient: matches[0].SavedSearch.TalentEmail,
+		Subject:   subject,
+		Body:      body.String(),
+	}
+}
+
+func (u *jobImpl) GetTalentSkills(ctx context.Context, req request.SearchTalentSkillsRequest) ([]string, error) {
+	return u.appDB.GetTalentSkills(ctx, req.TalentId)
+}
+
+func (u *jobImpl) UpdateTalentSkills(ctx context.Context, req request.UpdateTalentSkillsRequest) error {
+	skills, err := normalizeSkills(req.Skills)
+	if err != nil {
+		return err
+	}
+	if len(skills) > maxSkillsPerTalent {
+		return ErrInvalidSkill
+	}
+	return u.appDB.UpdateTalentSkills(ctx, req.TalentId, skills)
+}
+
+// GetRecommendedJobs ranks the open jobs the talent has neither applied to nor
+// saved. Each job scores
+//
+//	skillOverlapWeight * (share of the job's skills the talent has) +
+//	textSimilarityWeight * (TF-IDF cosine similarity to the talent's profile)
+//
+// where the profile is built from the talent's skills and the jobs they
+// applied to or saved. The parts that contributed are returned as reasons.
+func (u *jobImpl) GetRecommendedJobs(ctx context.Context, req request.SearchRecommendedJobRequest) (*[]model.RecommendedJob, error) {
+	if req.Limit < 1 || req.Limit > maxRecommendedJobs {
+		req.Limit = maxRecommendedJobs
+	}
+
+	talentSkills, err := u.appDB.GetTalentSkills(ctx, req.TalentId)
+	if err != nil {
+		return nil, err
+	}
+
+	appliedJobs, err := u.appDB.GetAppliedJobsByTalentId(ctx, req.TalentId, maxRecommendationHistory)
+	if err != nil {
+		return nil, err
+	}
+
+	savedJobs, err := u.appDB.GetSavedJobs(ctx, req.TalentId, maxRecommendationHistory, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs, err := u.appDB.GetAllJob(ctx, model.JobFilter{OpenOnly: true})
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[int]bool)
+	profile := text.Terms(strings.Join(talentSkills, " "))
+	for _, job := range *appliedJobs {
+		seen[job.ID] = true
+		profile = append(profile, jobTerms(job)...)
+	}
+	for _, savedJob := range *savedJobs {
+		seen[savedJob.Job.ID] = true
+		profile = append(profile, jobTerms(savedJob.Job)...)
+	}
+
+	candidates := []model.Job{}
+	documents := [][]string{}
+	for _, job := range *jobs {
+		if !seen[job.ID] {
+			candidates = append(candidates, job)
+			documents = append(documents, jobTerms(job))
+		}
+	}
+
+	corpus := text.NewCorpus(documents)
+	profileVector := corpus.Vector(profile)
+
+	hasSkill := make(map[string]bool, len(talentSkills))
+	for _, skill := range talentSkills {
+		hasSkill[skill] = true
+	}
+
+	recommendations := []model.RecommendedJob{}
+	for i, job := range candidates {
+		reasons := []string{}
+
+		matchedSkills := []string{}
+		for _, skill := range job.Skills {
+			if hasSkill[skill] {
+				matchedSkills = append(matchedSkills, skill)
+			}
+		}
+		var skillOverlap float64
+		if len(job.Skills) > 0 {
+			skillOverlap = float64(len(matchedSkills)) / float64(len(job.Skills))
+		}
+		if len(matchedSkills) > 0 {
+			reasons = append(reasons, fmt.Sprintf("Matches %d of %d required skills: %s", len(matchedSkills), len(job.Skills), strings.Join(matchedSkills, ", ")))
+		}
+
+		jobVector := corpus.Vector(documents[i])
+		similarity := text.Cosine(profileVector, jobVector)
+		if sharedTerms := text.TopSharedTerms(profileVector, jobVector, maxRecommendationTerms); len(sharedTerms) > 0 {
+			reasons = append(reasons, fmt.Sprintf("Shares keywords with your skills and job history: %s", strings.Join(sharedTerms, ", ")))
+		}
+
+		score := skillOverlapWeight*skillOverlap + textSimilarityWeight*similarity
+		if score <= 0 {
+			continue
+		}
+
+		recommendations = append(recommendations, model.RecommendedJob{
+			Job:     job,
+			Score:   math.Round(score*10000) / 10000,
+			Reasons: reasons,
+		})
+	}
+
+	sort.SliceStable(recommendations, func(i, j int) bool {
+		if recommendations[i].Score != recommendations[j].Score {
+			return recommendations[i].Score > recommendations[j].Score
+		}
+		return recommendations[i].Job.ID > recommendations[j].Job.ID
+	})
+	if len(recommendations) > req.Limit {
+		recommendations = recommendations[:req.Limit]
+	}
+	return &recommendations, nil
+}
+
+// normalizeSkill lower-cases a skill tag and collapses its whitespace so that
+// "Machine  Learning" and "machine learning" resolve to the same skill.
+func normalizeSkill(skill string) string {
+	return strings.Join(strings.Fields(strings.ToLower(skill)), " ")
+}
+
+func normalizeSkills(skills []string) ([]string, error) {
+	seen := make(map[string]bool, len(skills))
+	normalized := make([]string, 0, len(skills))
+	for _, skill := range skills {
+		skill = normalizeSkill(skill)
+		if skill == "" || seen[skill] {
+			continue
+		}
+		if len(skill) > maxSkillLength {
+			return nil, ErrInvalidSkill
+		}
+		seen[skill] = true
+		normalized = append(normalized, skill)
+	}
+	return normalized, nil
+}
+
+func jobTerms(job model.Job) []string {
+	terms := text.Terms(strings.Join([]string{job.Title, job.Description, job.Requirement}, " "))
+	return append(terms, text.Terms(strings.Join(job.Skills, " "))...)
+}
+
+func isJobExpired(job model.Job, now time.Time) bool {
+	return job.ExpireDate != nil && !job.ExpireDate.After(now)
+}
+
+// getDuplicateCandidates returns the employer's postings within the
+// duplicate window, or nothing when duplicate detection is off.
+func (u *jobImpl) getDuplicateCandidates(ctx context.Context, employerId int) ([]model.Job, error) {
+	if u.moderation.DuplicatePolicy == enum.DuplicatePolicyOff {
+		return nil, nil
+	}
+
+	jobs, err := u.appDB.GetRecentJobsByEmployerId(ctx, employerId, u.moderation.DuplicateWindowDays)
+	if err != nil {
+		return nil, err
+	}
+	return *jobs, nil
+}
+
+// findDuplicateJob returns the index of the first candidate with the same
+// normalised title whose description shingles overlap the job's by at least
+// threshold, or -1 when there is none.
+func findDuplicateJob(job model.Job, candidates []model.Job, threshold float64) int {
+	title := text.Normalize(job.Title)
+	shingles := text.Shingles(job.Description, duplicateShingleSize)
+	for i, candidate := range candidates {
+		if text.Normalize(candidate.Title) != title {
+			continue
+		}
+		if text.Jaccard(shingles, text.Shingles(candidate.Description, duplicateShingleSize)) >= threshold {
+			return i
+		}
+	}
+	return -1
+}
+
+func (u *jobImpl) isEmployerVerified(ctx context.Context, employerId int) (bool, error) {
+	employer, err := u.appDB.GetUserById(ctx, employerId)
+	if err != nil {
+		return false, err
+	}
+	return employer.IsVerified, nil
+}
+
+// screenJob runs the rule based spam filter over the job. Flagged jobs and
+// every job of an unverified employer are held for moderation instead of
+// being published.
+func screenJob(job *model.Job, verified bool, bannedPhrases []string) {
+	job.ModerationStatus = enum.ApprovedModerationStatus
+	job.ModerationFlags = spamFlags(*job, bannedPhrases)
+	if !verified {
+		job.ModerationFlags = append(job.ModerationFlags, unverifiedEmployerFlag)
+	}
+	if len(job.ModerationFlags) > 0 {
+		job.ModerationStatus = enum.PendingModerationStatus
+	}
+}
+
+func spamFlags(job model.Job, bannedPhrases []string) []string {
+	parts := []string{job.Title, job.Description, job.Requirement}
+	for _, translation := range job.Translations {
+		parts = append(parts, translation.Title, translation.Description, translation.Requirement)
+	}
+	document := strings.Join(parts, "\n")
+
+	flags := []string{}
+	for _, phrase := range bannedPhrases {
+		if text.ContainsPhrase(document, phrase) {
+			flags = append(flags, fmt.Sprintf(bannedPhraseFlag, phrase))
+		}
+	}
+
+	if len(linkPattern.FindAllStringIndex(document, -1)) > maxJobLinks {
+		flags = append(flags, tooManyLinksFlag)
+	}
+
+	letters, uppercase := 0, 0
+	for _, r := range job.Title {
+		if unicode.IsLetter(r) {
+			letters++
+			if unicode.IsUpper(r) {
+				uppercase++
+			}
+		}
+	}
+	if letters >= minShoutingLetters && float64(uppercase)/float64(letters) > maxUppercaseRatio {
+		flags = append(flags, shoutingTitleFlag)
+	}
+
+	if repeatedSymbolsPattern.MatchString(document) {
+		flags = append(flags, repeatedSymbolsFlag)
+	}
+	return flags
+}
+
+// newJob validates the request and turns it into the job to insert.
+func newJob(req request.InsertJobRequest) (model.Job, error) {
+	if len(req.Description) > maxDescriptionLength {
+		return model.Job{}, ErrInvalidDescription
+	}
+	if len(req.Requirement) > maxRequirementLength {
+		return model.Job{}, ErrInvalidRequirement
+	}
+
+	skills, err := normalizeSkills(req.Skills)
+	if err != nil {
+		return model.Job{}, err
+	}
+	if len(skills) > maxSkillsPerJob {
+		return model.Job{}, ErrInvalidSkill
+	}
+
+	if req.ExpireDate != nil {
+		if !req.ExpireDate.After(time.Now()) {
+			return model.Job{}, ErrInvalidExpireDate
+		}
+		expireDate := req.ExpireDate.UTC()
+		req.ExpireDate = &expireDate
+	}
+
+	if req.Openings != nil && *req.Openings < 1 {
+		return model.Job{}, ErrInvalidOpenings
+	}
+	if req.MaxApplications != nil && *req.MaxApplications < 1 {
+		return model.Job{}, ErrInvalidMaxApplications
+	}
+
+	questions, err := normalizeQuestions(req.Questions)
+	if err != nil {
+		return model.Job{}, err
+	}
+
+	requiredFields, err := normalizeRequiredFields(req.RequiredFields)
+	if err != nil {
+		return model.Job{}, err
+	}
+
+	job := model.Job{
+		EmployerId:      req.EmployerId,
+		Title:           req.Title,
+		Description:     req.Description,
+		Requirement:     req.Requirement,
+		Skills:          skills,
+		ExpireDate:      req.ExpireDate,
+		Questions:       questions,
+		Openings:        req.Openings,
+		MaxApplications: req.MaxApplications,
+		RequiredFields:  requiredFields,
+	}
+
+	job.Language, job.Translations, err = normalizeTranslations(req.Language, req.Translations)
+	if err != nil {
+		return model.Job{}, err
+	}
+
+	// Locations are geocoded from the bundled city list, so jobs can be
+	// searched by distance without an external geocoding service.
+	if city := strings.TrimSpace(req.City); city != "" {
+		match, ok := geo.Lookup(city)
+		if !ok {
+			return model.Job{}, ErrInvalidCity
+		}
+		job.City = &match.Name
+		job.Latitude = &match.Latitude
+		job.Longitude = &match.Longitude
+	}
+	return job, nil
+}
+
+// normalizeRequiredFields checks the application fields a job requires and
+// lists each of them once.
+func normalizeRequiredFields(fields []string) ([]string, error) {
+	requested := map[string]bool{}
+	for _, field := range fields {
+		field = strings.ToLower(strings.TrimSpace(field))
+		if !isApplicationField(field) {
+			return nil, ErrInvalidRequiredField
+		}
+		requested[field] = true
+	}
+
+	required := []string{}
+	for _, field := range applicationFields {
+		if requested[field] {
+			required = append(required, field)
+		}
+	}
+	return required, nil
+}
+
+func isApplicationField(field string) bool {
+	for _, applicationField := range applicationFields {
+		if field == applicationField {
+			return true
+		}
+	}
+	return false
+}
+
+// newApplication validates the fields a talent filled in when applying and
+// checks that every field the job requires was given.
+func newApplication(req request.InsertApplicationRequest, requiredFields []string, now time.Time) (model.Application, error) {
+	application := model.Application{
+		JobId:          req.JobId,
+		TalentId:       req.TalentId,
+		CoverLetter:    strings.TrimSpace(req.CoverLetter),
+		ExpectedSalary: req.ExpectedSalary,
+		Links:          []string{},
+	}
+
+	if len(application.CoverLetter) > maxCoverLetterLength {
+		return model.Application{}, ErrInvalidCoverLetter
+	}
+	if req.ExpectedSalary != nil && *req.ExpectedSalary < 1 {
+		return model.Application{}, ErrInvalidExpectedSalary
+	}
+
+	// Only the date is kept, read in the offset the talent sent it with.
+	if req.AvailableFrom != nil {
+		year, month, day := req.AvailableFrom.Date()
+		availableFrom := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		year, month, day = now.UTC().Date()
+		if availableFrom.Before(time.Date(year, month, day, 0, 0, 0, 0, time.UTC)) {
+			return model.Application{}, ErrInvalidAvailableFrom
+		}
+		application.AvailableFrom = &availableFrom
+	}
+
+	seen := map[string]bool{}
+	for _, link := range req.Links {
+		link = strings.TrimSpace(link)
+		parsed, err := url.Parse(link)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" || len(link) > maxLinkLength {
+			return model.Application{}, ErrInvalidLink
+		}
+		if !seen[link] {
+			seen[link] = true
+			application.Links = append(application.Links, link)
+		}
+	}
+	if len(application.Links) > maxApplicationLinks {
+		return model.Application{}, ErrInvalidLink
+	}
+
+	for _, field := range requiredFields {
+		missing := false
+		switch field {
+		case enum.CoverLetterField:
+			missing = application.CoverLetter == ""
+		case enum.ExpectedSalaryField:
+			missing = application.ExpectedSalary == nil
+		case enum.AvailableFromField:
+			missing = application.AvailableFrom == nil
+		case enum.LinksField:
+			missing = len(application.Links) == 0
+		}
+		if missing {
+			return model.Application{}, &MissingFieldError{Field: field}
+		}
+	}
+	return application, nil
+}
+
+// normalizeTranslations validates the primary language of a job and its
+// translations. Every translation needs a title and its own language, while
+// an empty description or requirement falls back to the primary one.
+func normalizeTranslations(language string, reqs []request.JobTranslationRequest) (string, []model.JobTranslation, error) {
+	if strings.TrimSpace(language) == "" {
+		language = defaultJobLanguage
+	}
+	language = locale.Normalize(language)
+	if language == "" {
+		return "", nil, ErrInvalidLanguage
+	}
+	if len(reqs) > maxTranslationsPerJob {
+		return "", nil, ErrInvalidTranslation
+	}
+
+	seen := map[string]bool{language: true}
+	translations := []model.JobTranslation{}
+	for _, req := range reqs {
+		translation := model.JobTranslation{
+			Language:    locale.Normalize(req.Language),
+			Title:       strings.TrimSpace(req.Title),
+			Description: req.Description,
+			Requirement: req.Requirement,
+		}
+		if translation.Language == "" || seen[translation.Language] || translation.Title == "" ||
+			len(translation.Description) > maxDescriptionLength || len(translation.Requirement) > maxRequirementLength {
+			return "", nil, ErrInvalidTranslation
+		}
+		seen[translation.Language] = true
+		translations = append(translations, translation)
+	}
+	return language, translations, nil
+}
+
+func normalizeQuestions(reqs []request.InsertJobQuestionRequest) ([]model.JobQuestion, error) {
+	if len(reqs) > maxQuestionsPerJob {
+		return nil, ErrInvalidQuestion
+	}
+
+	questions := []model.JobQuestion{}
+	for i, req := range reqs {
+		question := model.JobQuestion{
+			Position:     i + 1,
+			Question:     strings.TrimSpace(req.Question),
+			QuestionType: req.QuestionType,
+			Options:      []string{},
+			// Stored as NOT NULL arrays, so never leave them nil.
+			KnockoutAnswers: []string{},
+		}
+		if question.Question == "" || len(question.Question) > maxQuestionLength {
+			return nil, ErrInvalidQuestion
+		}
+
+		switch req.QuestionType {
+		case enum.YesNoQuestion:
+			question.Options = []string{"yes", "no"}
+		case enum.SingleChoiceQuestion:
+			seen := map[string]bool{}
+			for _, option := range req.Options {
+				option = strings.TrimSpace(option)
+				if option == "" || len(option) > maxOptionLength {
+					return nil, ErrInvalidQuestion
+				}
+				if seen[strings.ToLower(option)] {
+					continue
+				}
+				seen[strings.ToLower(option)] = true
+				question.Options = append(question.Options, option)
+			}
+			if len(question.Options) < 2 || len(question.Options) > maxQuestionOptions {
+				return nil, ErrInvalidQuestion
+			}
+		case enum.NumericQuestion, enum.FreeTextQuestion:
+			if len(req.Options) > 0 {
+				return nil, ErrInvalidQuestion
+			}
+		default:
+			return nil, ErrInvalidQuestion
+		}
+
+		switch req.QuestionType {
+		case enum.YesNoQuestion, enum.SingleChoiceQuestion:
+			if req.KnockoutMin != nil || req.KnockoutMax != nil {
+				return nil, ErrInvalidQuestion
+			}
+			for _, knockout := range req.KnockoutAnswers {
+				option, ok := matchOption(question.Options, knockout)
+				if !ok {
+					return nil, ErrInvalidQuestion
+				}
+				question.KnockoutAnswers = append(question.KnockoutAnswers, option)
+			}
+			if len(question.KnockoutAnswers) >= len(question.Options) {
+				return nil, ErrInvalidQuestion
+			}
+		case enum.NumericQuestion:
+			if len(req.KnockoutAnswers) > 0 {
+				return nil, ErrInvalidQuestion
+			}
+			if req.KnockoutMin != nil && req.KnockoutMax != nil && *req.KnockoutMin > *req.KnockoutMax {
+				return nil, ErrInvalidQuestion
+			}
+			question.KnockoutMin = req.KnockoutMin
+			question.KnockoutMax = req.KnockoutMax
+		case enum.FreeTextQuestion:
+			if len(req.KnockoutAnswers) > 0 || req.KnockoutMin != nil || req.KnockoutMax != nil {
+				return nil, ErrInvalidQuestion
+			}
+		}
+		questions = append(questions, question)
+	}
+	return questions, nil
+}
+
+// evaluateAnswers checks that every question is answered exactly once and
+// marks the answers that trip a knockout rule.
+func evaluateAnswers(questions []model.JobQuestion, reqs []request.InsertApplicationAnswerRequest) ([]model.ApplicationAnswer, error) {
+	answersByQuestion := map[int]string{}
+	for _, req := range reqs {
+		if _, ok := answersByQuestion[req.QuestionId]; ok {
+			return nil, ErrInvalidAnswer
+		}
+		answersByQuestion[req.QuestionId] = strings.TrimSpace(req.Answer)
+	}
+	if len(answersByQuestion) != len(questions) {
+		return nil, ErrInvalidAnswer
+	}
+
+	answers := []model.ApplicationAnswer{}
+	for _, question := range questions {
+		value, ok := answersByQuestion[question.ID]
+		if !ok || value == "" {
+			return nil, ErrInvalidAnswer
+		}
+
+		answer := model.ApplicationAnswer{
+			QuestionId: question.ID,
+			Question:   question.Question,
+		}
+
+		switch question.QuestionType {
+		case enum.YesNoQuestion, enum.SingleChoiceQuestion:
+			option, ok := matchOption(question.Options, value)
+			if !ok {
+				return nil, ErrInvalidAnswer
+			}
+			answer.Answer = option
+			_, answer.KnockedOut = matchOption(question.KnockoutAnswers, option)
+		case enum.NumericQuestion:
+			number, err := strconv.ParseFloat(value, 64)
+			if err != nil || math.IsNaN(number) || math.IsInf(number, 0) {
+				return nil, ErrInvalidAnswer
+			}
+			answer.Answer = value
+			answer.KnockedOut = (question.KnockoutMin != nil && number < *question.KnockoutMin) ||
+				(question.KnockoutMax != nil && number > *question.KnockoutMax)
+		default:
+			if len(value) > maxFreeTextAnswerSize {
+				return nil, ErrInvalidAnswer
+			}
+			answer.Answer = value
+		}
+		answers = append(answers, answer)
+	}
+	return answers, nil
+}
+
+func matchOption(options []string, value string) (string, bool) {
+	value = strings.TrimSpace(value)
+	for _, option := range options {
+		if strings.EqualFold(option, value) {
+			return option, true
+		}
+	}
+	return "", false
+}
+
+// diffJobRevisions compares every field of the two revisions line by line and
+// keeps the fields that changed. Skills are compared one per line.
+func diffJobRevisions(from, to model.JobRevision) []model.JobRevisionChange {
+	fields := []struct {
+		name     string
+		from, to string
+	}{
+		{"title", from.Title, to.Title},
+		{"description", from.Description, to.Description},
+		{"requirement", from.Requirement, to.Requirement},
+		{"skills", strings.Join(from.Skills, "\n"), strings.Join(to.Skills, "\n")},
+		{"expire_date", formatRevisionDate(from.ExpireDate), formatRevisionDate(to.ExpireDate)},
+	}
+
+	changes := []model.JobRevisionChange{}
+	for _, field := range fields {
+		lines := diff.Lines(field.from, field.to)
+		if !diff.Changed(lines) {
+			continue
+		}
+
+		change := model.JobRevisionChange{Field: field.name, Lines: []model.DiffLine{}}
+		for _, line := range lines {
+			change.Lines = append(change.Lines, model.DiffLine{Op: line.Op, Text: line.Text})
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+func formatRevisionDate(date *time.Time) string {
+	if date == nil {
+		return ""
+	}
+	return date.UTC().Format(time.RFC3339)
+}
+
+type importRow struct {
+	req    request.InsertJobRequest
+	errors []string
+}
+
+// parseCSVImport reads a CSV file whose header names the columns title,
+// description, requirement, skills, openings, max_applications, city,
+// language, required_fields and expire_date. Skills and required fields are
+// separated by semicolons and only the title column is mandatory.
+func parseCSVImport(data []byte) ([]importRow, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, utf8BOM)))
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, ErrInvalidImport
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := columns["title"]; !ok {
+		return nil, ErrInvalidImport
+	}
+
+	rows := []importRow{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, ErrInvalidImport
+			}
+			rows = append(rows, importRow{errors: []string{importMalformedRowMsg}})
+			continue
+		}
+
+		field := func(name string) string {
+			if i, ok := columns[name]; ok {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		row := importRow{req: request.InsertJobRequest{
+			Title:       field("title"),
+			Description: field("description"),
+			Requirement: field("requirement"),
+			City:        field("city"),
+			Language:    field("language"),
+		}}
+		if skills := field("skills"); skills != "" {
+			row.req.Skills = strings.Split(skills, importListSeparator)
+		}
+		if requiredFields := field("required_fields"); requiredFields != "" {
+			row.req.RequiredFields = strings.Split(requiredFields, importListSeparator)
+		}
+		if openings := field("openings"); openings != "" {
+			number, err := strconv.Atoi(openings)
+			if err != nil {
+				row.errors = append(row.errors, ErrInvalidOpenings.Error())
+			}
+			row.req.Openings = &number
+		}
+		if maxApplications := field("max_applications"); maxApplications != "" {
+			number, err := strconv.Atoi(maxApplications)
+			if err != nil {
+				row.errors = append(row.errors, ErrInvalidMaxApplications.Error())
+			}
+			row.req.MaxApplications = &number
+		}
+		if expireDate := field("expire_date"); expireDate != "" {
+			date, err := parseImportDate(expireDate)
+			if err != nil {
+				row.errors = append(row.errors, ErrInvalidExpireDate.Error())
+			}
+			row.req.ExpireDate = date
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// parseJSONLImport reads one job per line in the same shape as the insert
+// job request. Blank lines are skipped.
+func parseJSONLImport(data []byte) ([]importRow, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(bytes.TrimPrefix(data, utf8BOM)))
+	scanner.Buffer(make([]byte, 0, 64*1024), maxImportLineSize)
+
+	rows := []importRow{}
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		row := importRow{}
+		err := json.Unmarshal(line, &row.req)
+		if err != nil {
+			row = importRow{errors: []string{importMalformedRowMsg}}
+		}
+		rows = append(rows, row)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, ErrInvalidImport
+	}
+	return rows, nil
+}
+
+// parseImportDate accepts either a full RFC 3339 timestamp or a plain date,
+// which is read as midnight UTC.
+func parseImportDate(value string) (*time.Time, error) {
+	date, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		date, err = time.Parse(time.DateOnly, value)
+		if err != nil {
+			return nil, err
+		}
 	}
-	return u.appDB.UpdateApplicationStatus(ctx, req.ApplicationId, req.Status)
+	return &date, nil
 }
@@ -8,12 +8,53 @@ import (
 )
 
 type JobUsecase interface {
-	GetAllJob(ctx context.Context) (*[]model.Job, error)
+	GetAllJob(ctx context.Context, req request.SearchJobRequest) (*[]model.Job, error)
 	GetJobById(ctx context.Context, req request.SearchJobByIdRequest) (*model.Job, error)
-	InsertJob(ctx context.Context, req request.InsertJobRequest) error
+	InsertJob(ctx context.Context, req request.InsertJobRequest) (*model.InsertJobResult, error)
+	UpdateJob(ctx context.Context, req request.UpdateJobRequest) error
+	GetModerationJobs(ctx context.Context, req request.SearchModerationJobRequest) (*[]model.ModerationJob, int, error)
+	ModerateJob(ctx context.Context, req request.ModerateJobRequest) error
+	ReportJob(ctx context.Context, req request.InsertJobReportRequest) error
+	GetJobReports(ctx context.Context, req request.SearchJobReportRequest) (*[]model.JobReport, int, error)
+	ResolveJobReport(ctx context.Context, req request.ResolveJobReportRequest) error
+	GetJobRevisions(ctx context.Context, req request.SearchJobRevisionRequest) (*[]model.JobRevision, error)
+	GetJobRevisionDiff(ctx context.Context, req request.SearchJobRevisionDiffRequest) (*model.JobRevisionDiff, error)
+	ImportJobs(ctx context.Context, req request.ImportJobRequest) (*model.JobImport, error)
+	GetJobImport(ctx context.Context, req request.SearchJobImportRequest) (*model.JobImport, error)
+	UpdateJobStatus(ctx context.Context, req request.UpdateJobStatusRequest) error
+	GetJobsByEmployerId(ctx context.Context, req request.SearchJobByEmployerRequest) (*[]model.EmployerJob, int, error)
+	SearchSkills(ctx context.Context, req request.SearchSkillRequest) (*[]model.Skill, error)
 
 	GetApplicationsByJobId(ctx context.Context, req request.SearchApplicationByJobRequest) (*[]model.Application, error)
 	GetApplicationById(ctx context.Context, req request.SearchApplicationByIdRequest) (*model.Application, error)
+	GetApplicationsByTalentId(ctx context.Context, req request.SearchApplicationByTalentRequest) (*[]model.Application, int, error)
 	InsertApplication(ctx context.Context, req request.InsertApplicationRequest) error
 	UpdateApplicationStatus(ctx context.Context, req request.UpdateApplicationStatusRequest) error
+	WithdrawApplication(ctx context.Context, req request.WithdrawApplicationRequest) error
+	InsertApplicationNote(ctx context.Context, req request.InsertApplicationNoteRequest) (*model.ApplicationNote, error)
+	DeleteApplicationNote(ctx context.Context, req request.DeleteApplicationNoteRequest) error
+	UpdateApplicationTags(ctx context.Context, req request.UpdateApplicationTagsRequest) error
+	GetScorecard(ctx context.Context, req request.SearchScorecardRequest) (*[]model.ScorecardCriterion, error)
+	UpdateScorecard(ctx context.Context, req request.UpdateScorecardRequest) error
+	UpdateApplicationScorecard(ctx context.Context, req request.UpdateApplicationScorecardRequest) error
+	InsertInterviewSlots(ctx context.Context, req request.InsertInterviewSlotsRequest) (*[]model.InterviewSlot, error)
+	GetInterviewSlots(ctx context.Context, req request.SearchInterviewSlotsRequest) (*[]model.InterviewSlot, error)
+	DeleteInterviewSlot(ctx context.Context, req request.DeleteInterviewSlotRequest) error
+	GetAvailableInterviewSlots(ctx context.Context, req request.SearchAvailableInterviewSlotsRequest) (*[]model.InterviewSlot, error)
+	BookInterview(ctx context.Context, req request.BookInterviewRequest) (*model.Interview, error)
+	CancelInterview(ctx context.Context, req request.CancelInterviewRequest) error
+	GetInterviewInvite(ctx context.Context, req request.SearchInterviewRequest) ([]byte, error)
+
+	SaveJob(ctx context.Context, req request.SaveJobRequest) error
+	UnsaveJob(ctx context.Context, req request.SaveJobRequest) error
+	GetSavedJobs(ctx context.Context, req request.SearchSavedJobRequest) (*[]model.SavedJob, int, error)
+
+	InsertSavedSearch(ctx context.Context, req request.InsertSavedSearchRequest) error
+	GetSavedSearches(ctx context.Context, req request.SearchSavedSearchRequest) (*[]model.SavedSearch, error)
+	DeleteSavedSearch(ctx context.Context, req request.DeleteSavedSearchRequest) error
+	SendSavedSearchDigest(ctx context.Context) error
+
+	GetTalentSkills(ctx context.Context, req request.SearchTalentSkillsRequest) ([]string, error)
+	UpdateTalentSkills(ctx context.Context, req request.UpdateTalentSkillsRequest) error
+	GetRecommendedJobs(ctx context.Context, req request.SearchRecommendedJobRequest) (*[]model.RecommendedJob, error)
 }
@@ -0,0 +1,182 @@
+package profile
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/michaelwongycn/job-portal/domain/enum"
+	"github.com/michaelwongycn/job-portal/domain/model"
+	"github.com/michaelwongycn/job-portal/domain/request"
+	"github.com/michaelwongycn/job-portal/repository/appDB"
+)
+
+const (
+	maxHeadlineLength    = 255
+	maxSummaryLength     = 5000
+	maxNameLength        = 255
+	maxDescriptionLength = 5000
+	maxExperiences       = 30
+	maxEducations        = 20
+	maxLinks             = 10
+	maxLinkLength        = 2048
+)
+
+var (
+	ErrInvalidProfile = errors.New("Invalid Profile")
+)
+
+type profileImpl struct {
+	appDB appDB.AppDBInterface
+}
+
+func NewProfileImpl(appDB appDB.AppDBInterface) ProfileUsecase {
+	return &profileImpl{
+		appDB: appDB,
+	}
+}
+
+// UpsertProfile replaces the talent's profile. Work history and education are
+// kept in the order they are sent.
+func (u *profileImpl) UpsertProfile(ctx context.Context, req request.UpsertTalentProfileRequest) error {
+	profile, err := newProfile(req)
+	if err != nil {
+		return err
+	}
+	return u.appDB.UpsertTalentProfile(ctx, profile)
+}
+
+func (u *profileImpl) GetProfile(ctx context.Context, req request.SearchTalentProfileRequest) (*model.TalentProfile, error) {
+	return u.appDB.GetTalentProfile(ctx, req.TalentId)
+}
+
+// GetTalentProfile shows a talent's profile to an employer. Public profiles are
+// shown to every employer, profiles with the default visibility only to
+// employers the talent applied to, and private profiles to nobody. Hidden
+// profiles are reported as missing.
+func (u *profileImpl) GetTalentProfile(ctx context.Context, req request.SearchTalentProfileRequest) (*model.TalentProfile, error) {
+	profile, err := u.appDB.GetTalentProfile(ctx, req.TalentId)
+	if err != nil {
+		return nil, err
+	}
+
+	switch profile.Visibility {
+	case enum.PublicProfileVisibility:
+		return profile, nil
+	case enum.ApplicationProfileVisibility:
+		applied, err := u.appDB.HasAppliedToEmployer(ctx, req.TalentId, req.EmployerId)
+		if err != nil {
+			return nil, err
+		}
+		if applied {
+			return profile, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (u *profileImpl) DeleteProfile(ctx context.Context, req request.DeleteTalentProfileRequest) error {
+	return u.appDB.DeleteTalentProfile(ctx, req.TalentId)
+}
+
+func newProfile(req request.UpsertTalentProfileRequest) (model.TalentProfile, error) {
+	profile := model.TalentProfile{
+		TalentId:    req.TalentId,
+		Headline:    strings.TrimSpace(req.Headline),
+		Summary:     strings.TrimSpace(req.Summary),
+		Experiences: []model.WorkExperience{},
+		Educations:  []model.Education{},
+		Links:       []string{},
+		Visibility:  req.Visibility,
+	}
+
+	if profile.Visibility == 0 {
+		profile.Visibility = enum.ApplicationProfileVisibility
+	}
+	if profile.Visibility < enum.PrivateProfileVisibility || profile.Visibility > enum.PublicProfileVisibility {
+		return model.TalentProfile{}, ErrInvalidProfile
+	}
+	if len(profile.Headline) > maxHeadlineLength || len(profile.Summary) > maxSummaryLength {
+		return model.TalentProfile{}, ErrInvalidProfile
+	}
+	if len(req.Experiences) > maxExperiences || len(req.Educations) > maxEducations || len(req.Links) > maxLinks {
+		return model.TalentProfile{}, ErrInvalidProfile
+	}
+
+	for _, req := range req.Experiences {
+		experience := model.WorkExperience{
+			Title:       strings.TrimSpace(req.Title),
+			Company:     strings.TrimSpace(req.Company),
+			Description: strings.TrimSpace(req.Description),
+		}
+		if experience.Title == "" || len(experience.Title) > maxNameLength || experience.Company == "" || len(experience.Company) > maxNameLength || len(experience.Description) > maxDescriptionLength {
+			return model.TalentProfile{}, ErrInvalidProfile
+		}
+
+		startDate, endDate, ok := normalizePeriod(req.StartDate, req.EndDate)
+		if !ok {
+			return model.TalentProfile{}, ErrInvalidProfile
+		}
+		experience.StartDate, experience.EndDate = startDate, endDate
+		profile.Experiences = append(profile.Experiences, experience)
+	}
+
+	for _, req := range req.Educations {
+		education := model.Education{
+			School:       strings.TrimSpace(req.School),
+			Degree:       strings.TrimSpace(req.Degree),
+			FieldOfStudy: strings.TrimSpace(req.FieldOfStudy),
+		}
+		if education.School == "" || len(education.School) > maxNameLength || len(education.Degree) > maxNameLength || len(education.FieldOfStudy) > maxNameLength {
+			return model.TalentProfile{}, ErrInvalidProfile
+		}
+
+		startDate, endDate, ok := normalizePeriod(req.StartDate, req.EndDate)
+		if !ok {
+			return model.TalentProfile{}, ErrInvalidProfile
+		}
+		education.StartDate, education.EndDate = startDate, endDate
+		profile.Educations = append(profile.Educations, education)
+	}
+
+	seen := map[string]bool{}
+	for _, link := range req.Links {
+		link = strings.TrimSpace(link)
+		parsed, err := url.Parse(link)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" || len(link) > maxLinkLength {
+			return model.TalentProfile{}, ErrInvalidProfile
+		}
+		if !seen[link] {
+			seen[link] = true
+			profile.Links = append(profile.Links, link)
+		}
+	}
+	return profile, nil
+}
+
+// normalizePeriod keeps only the dates of a period, which needs a start and
+// ends no earlier than it starts. A missing end means the period is ongoing.
+func normalizePeriod(start, end *time.Time) (time.Time, *time.Time, bool) {
+	if start == nil {
+		return time.Time{}, nil, false
+	}
+
+	startDate := dateOnly(*start)
+	if end == nil {
+		return startDate, nil, true
+	}
+
+	endDate := dateOnly(*end)
+	if endDate.Before(startDate) {
+		return time.Time{}, nil, false
+	}
+	return startDate, &endDate, true
+}
+
+func dateOnly(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
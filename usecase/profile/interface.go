@@ -0,0 +1,15 @@
+package profile
+
+import (
+	"context"
+
+	"github.com/michaelwongycn/job-portal/domain/model"
+	"github.com/michaelwongycn/job-portal/domain/request"
+)
+
+type ProfileUsecase interface {
+	UpsertProfile(ctx context.Context, req request.UpsertTalentProfileRequest) error
+	GetProfile(ctx context.Context, req request.SearchTalentProfileRequest) (*model.TalentProfile, error)
+	GetTalentProfile(ctx context.Context, req request.SearchTalentProfileRequest) (*model.TalentProfile, error)
+	DeleteProfile(ctx context.Context, req request.DeleteTalentProfileRequest) error
+}
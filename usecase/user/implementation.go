@@ -6,6 +6,7 @@ import (
 	"errors"
 	"time"
 
+	"github.com/michaelwongycn/job-portal/domain/enum"
 	"github.com/michaelwongycn/job-portal/domain/request"
 	"github.com/michaelwongycn/job-portal/lib/auth"
 	"github.com/michaelwongycn/job-portal/lib/cache"
@@ -13,6 +14,10 @@ import (
 	"github.com/michaelwongycn/job-portal/repository/appDB"
 )
 
+// ErrInvalidRole is returned when registering with a role other than talent
+// or employer. Admin accounts can't be created through registration.
+var ErrInvalidRole = errors.New("Invalid Role")
+
 type userImpl struct {
 	appDB                appDB.AppDBInterface
 	refreshTokenDuration time.Duration
@@ -64,6 +69,10 @@ func (u *userImpl) Login(ctx context.Context, req request.UserLoginRequest) (*st
 func (u *userImpl) Register(ctx context.Context, req request.UserRegisterRequest) (*string, *string, error) {
 	currTime := time.Now()
 
+	if req.Role != enum.TalentRole && req.Role != enum.EmployerRole {
+		return nil, nil, ErrInvalidRole
+	}
+
 	encryptedPassword, err := encrypt.Hash(req.Password)
 	if err != nil {
 		return nil, nil, err
@@ -119,3 +128,7 @@ func (u *userImpl) RefreshToken(ctx context.Context, req request.UserRefreshToke
 	cache.SetCache(newAccessToken, newRefreshToken)
 	return &newAccessToken, &newRefreshToken, nil
 }
+
+func (u *userImpl) VerifyEmployer(ctx context.Context, req request.VerifyEmployerRequest) error {
+	return u.appDB.VerifyEmployer(ctx, req.EmployerId)
+}
@@ -11,4 +11,5 @@ type UserUsecase interface {
 	Register(ctx context.Context, req request.UserRegisterRequest) (*string, *string, error)
 	Logout(ctx context.Context, req request.UserLogoutRequest) error
 	RefreshToken(ctx context.Context, req request.UserRefreshTokenRequest) (*string, *string, error)
+	VerifyEmployer(ctx context.Context, req request.VerifyEmployerRequest) error
 }